@@ -1,13 +1,25 @@
 package aws
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -159,6 +171,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
@@ -171,10 +184,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/mitchellh/go-homedir"
+	tfendpoints "github.com/terraform-providers/terraform-provider-aws/aws/internal/endpoints"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tflogging "github.com/terraform-providers/terraform-provider-aws/aws/internal/logging"
+	tfretry "github.com/terraform-providers/terraform-provider-aws/aws/internal/retry"
+	tfsts "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sts"
 	"github.com/terraform-providers/terraform-provider-aws/version"
+	"golang.org/x/net/http/httpproxy"
 )
 
 type Config struct {
@@ -185,6 +204,10 @@ type Config struct {
 	Token         string
 	Region        string
 	MaxRetries    int
+	RetryMode     string
+
+	SharedConfigFiles      []string
+	SharedCredentialsFiles []string
 
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
@@ -195,13 +218,24 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	DefaultTagsConfig *keyvaluetags.DefaultConfig
-	Endpoints         map[string]string
-	IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
-	Insecure          bool
+	DefaultTagsConfig  *keyvaluetags.DefaultConfig
+	Endpoints          map[string]string
+	IgnoreTagsConfig   *keyvaluetags.IgnoreConfig
+	Insecure           bool
+	RequiredTagsConfig *keyvaluetags.RequiredConfig
+
+	UseDualStackEndpoint bool
+	UseFIPSEndpoint      bool
+
+	CustomCABundle string
+	HTTPProxy      string
+	HTTPSProxy     string
+	NoProxy        string
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -213,6 +247,18 @@ type Config struct {
 	terraformVersion string
 }
 
+// AssumeRoleWithWebIdentity is the configuration for assuming an IAM Role
+// using an OpenID Connect or OAuth 2.0 web identity token.
+type AssumeRoleWithWebIdentity struct {
+	Duration             time.Duration
+	Policy               string
+	PolicyARNs           []string
+	RoleARN              string
+	SessionName          string
+	WebIdentityToken     string
+	WebIdentityTokenFile string
+}
+
 type AWSClient struct {
 	accessanalyzerconn                  *accessanalyzer.AccessAnalyzer
 	accountid                           string
@@ -284,6 +330,7 @@ type AWSClient struct {
 	elbv2conn                           *elbv2.ELBV2
 	emrconn                             *emr.EMR
 	emrcontainersconn                   *emrcontainers.EMRContainers
+	endpointVariant                     tfendpoints.Variant
 	esconn                              *elasticsearch.ElasticsearchService
 	firehoseconn                        *firehose.Firehose
 	fmsconn                             *fms.FMS
@@ -346,6 +393,8 @@ type AWSClient struct {
 	rdsconn                             *rds.RDS
 	redshiftconn                        *redshift.Redshift
 	region                              string
+	regionalClients                     *regionalClientCache
+	RequiredTagsConfig                  *keyvaluetags.RequiredConfig
 	resourcegroupsconn                  *resourcegroups.ResourceGroups
 	resourcegroupstaggingapiconn        *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
 	reverseDnsPrefix                    string
@@ -403,6 +452,24 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.region, client.dnsSuffix)
 }
 
+// S3RegionalHostname returns an S3 hostname with the provider domain suffix for the region and partition
+// and the provider FIPS and dual-stack endpoint configuration,
+// e.g. PREFIX.us-west-2.amazonaws.com, or PREFIX-fips.dualstack.us-west-2.amazonaws.com with use_fips_endpoint and use_dualstack_endpoint
+// Only use for hostnames that S3 publishes FIPS and dual-stack variants of, such as access point hostnames.
+// The prefix should not contain a trailing period.
+func (client *AWSClient) S3RegionalHostname(prefix string) string {
+	return client.endpointVariant.S3RegionalHostname(prefix, client.region, client.dnsSuffix)
+}
+
+// EndpointResolver returns an endpoint resolver for the provider FIPS and dual-stack endpoint configuration.
+func (client *AWSClient) EndpointResolver() endpoints.Resolver {
+	resolver := tfendpoints.NewResolver(endpoints.DefaultResolver())
+
+	return endpoints.ResolverFunc(func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		return resolver.EndpointFor(service, region, append([]func(*endpoints.Options){client.endpointVariant.Options}, optFns...)...)
+	})
+}
+
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client() (interface{}, error) {
 	// Get the auth and region. This can fail if keys/regions were not
@@ -426,7 +493,6 @@ func (c *Config) Client() (interface{}, error) {
 		CallerDocumentationURL:      "https://registry.terraform.io/providers/hashicorp/aws",
 		CallerName:                  "Terraform AWS Provider",
 		CredsFilename:               c.CredsFilename,
-		DebugLogging:                logging.IsDebugOrHigher(),
 		IamEndpoint:                 c.Endpoints["iam"],
 		Insecure:                    c.Insecure,
 		MaxRetries:                  c.MaxRetries,
@@ -446,11 +512,81 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	httpClient, err := c.httpClient()
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	sourceCreds, err := c.sourceCredentials(httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	if sourceCreds != nil {
+		v, err := sourceCreds.Get()
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		// The initial source credentials only satisfy the session
+		// credential checks; the refreshable credentials and any chained
+		// assume_role are configured and validated on the session below.
+		awsbaseConfig.AccessKey = v.AccessKeyID
+		awsbaseConfig.SecretKey = v.SecretAccessKey
+		awsbaseConfig.Token = v.SessionToken
+		awsbaseConfig.AssumeRoleARN = ""
+		awsbaseConfig.CredsFilename = ""
+		awsbaseConfig.Profile = ""
+		awsbaseConfig.SkipCredsValidation = true
+		awsbaseConfig.SkipRequestingAccountId = true
+	}
+
 	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	if httpClient != nil {
+		sess = sess.Copy(&aws.Config{HTTPClient: httpClient})
+	}
+
+	// Requests are logged by the provider, rather than the AWS SDK debug logger,
+	// so that sensitive values such as secrets and credentials can be redacted.
+	if logging.IsDebugOrHigher() {
+		logger := tflogging.NewLogger()
+
+		sess.Handlers.Send.PushBackNamed(logger.SendHandler())
+		sess.Handlers.Complete.PushBackNamed(logger.CompleteHandler())
+	}
+
+	endpointVariant := c.endpointVariant()
+
+	// Service clients without an explicit endpoint resolve the FIPS and dual-stack
+	// endpoint variants modeled in the AWS SDK, or fail if the variant is not available.
+	if !endpointVariant.IsDefault() {
+		sess = sess.Copy(&aws.Config{
+			EndpointResolver:     tfendpoints.NewResolver(sess.Config.EndpointResolver),
+			UseDualStackEndpoint: endpointVariant.DualStackEndpointState(),
+			UseFIPSEndpoint:      endpointVariant.FIPSEndpointState(),
+		})
+		sess.Handlers.Validate.PushFrontNamed(endpointVariant.ValidateEndpointHandler())
+	}
+
+	if sourceCreds != nil {
+		creds := sourceCreds
+
+		if c.AssumeRoleARN != "" {
+			creds = c.assumeRoleCredentials(sess.Copy(&aws.Config{Credentials: sourceCreds}))
+		}
+
+		sess = sess.Copy(&aws.Config{Credentials: creds})
+
+		accountID, partition, err = c.accountIDAndPartition(sess)
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -459,8 +595,49 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	// Service-specific retry rules and, in adaptive retry mode, client-side
+	// rate limiting are shared by every service client copied from the session.
+	sess.Handlers.Retry.PushBackNamed(tfretry.DefaultPolicy().Handler())
+
+	if c.RetryMode == tfretry.ModeAdaptive {
+		rateLimiters := tfretry.NewRateLimiters()
+
+		sess.Handlers.Send.PushFrontNamed(rateLimiters.SendHandler())
+		sess.Handlers.Send.AfterEachFn = request.HandlerListStopOnError
+		sess.Handlers.CompleteAttempt.PushBackNamed(rateLimiters.CompleteAttemptHandler())
+	}
+
+	client := c.awsClient(sess, accountID, partition, c.Region)
+
+	// Resources and data sources with a region argument use clients for other regions
+	// built on demand from the same session, sharing credentials, retry handlers and rate limits.
+	client.regionalClients = &regionalClientCache{
+		clients: map[string]*AWSClient{c.Region: client},
+		newClient: func(region string) *AWSClient {
+			return c.awsClient(sess.Copy(&aws.Config{Region: aws.String(region)}), accountID, partition, region)
+		},
+		partition:            partition,
+		skipRegionValidation: c.SkipRegionValidation,
+	}
+
+	if !c.SkipGetEC2Platforms {
+		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
+		if err != nil {
+			// We intentionally fail *silently* because there's a chance
+			// user just doesn't have ec2:DescribeAccountAttributes permissions
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		} else {
+			client.supportedplatforms = supportedPlatforms
+		}
+	}
+
+	return client, nil
+}
+
+// awsClient returns an AWSClient with service clients for the specified region copied from the session.
+func (c *Config) awsClient(sess *session.Session, accountID, partition, region string) *AWSClient {
 	dnsSuffix := "amazonaws.com"
-	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
 		dnsSuffix = p.DNSSuffix()
 	}
 
@@ -535,6 +712,7 @@ func (c *Config) Client() (interface{}, error) {
 		elbv2conn:                           elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])})),
 		emrconn:                             emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["emr"])})),
 		emrcontainersconn:                   emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["emrcontainers"])})),
+		endpointVariant:                     c.endpointVariant(),
 		esconn:                              elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["es"])})),
 		firehoseconn:                        firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["firehose"])})),
 		fmsconn:                             fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fms"])})),
@@ -593,7 +771,8 @@ func (c *Config) Client() (interface{}, error) {
 		ramconn:                             ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ram"])})),
 		rdsconn:                             rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["rds"])})),
 		redshiftconn:                        redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["redshift"])})),
-		region:                              c.Region,
+		region:                              region,
+		RequiredTagsConfig:                  c.RequiredTagsConfig,
 		resourcegroupsconn:                  resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])})),
 		resourcegroupstaggingapiconn:        resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroupstaggingapi"])})),
 		reverseDnsPrefix:                    ReverseDns(dnsSuffix),
@@ -676,218 +855,363 @@ func (c *Config) Client() (interface{}, error) {
 	client.r53conn = route53.New(sess.Copy(route53Config))
 	client.shieldconn = shield.New(sess.Copy(shieldConfig))
 
-	client.apigatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Many operations can return an error such as:
-		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
-		// Handle them all globally for the service client.
-		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	return client
+}
 
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+// endpointVariant returns the configured FIPS and dual-stack endpoint variant.
+func (c *Config) endpointVariant() tfendpoints.Variant {
+	return tfendpoints.Variant{
+		DualStack: c.UseDualStackEndpoint,
+		FIPS:      c.UseFIPSEndpoint,
+	}
+}
 
-	client.appsyncconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if isAWSErr(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+// httpClient returns the HTTP client shared by every service connection,
+// or nil if the provider configuration does not customize it.
+func (c *Config) httpClient() (*http.Client, error) {
+	if c.HTTPProxy == "" && c.HTTPSProxy == "" && c.NoProxy == "" && c.CustomCABundle == "" {
+		return nil, nil
+	}
 
-	client.cloudhsmv2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
 
-	client.configconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling Config Organization Rules API actions immediately
-		// after Organization creation, the API can randomly return the
-		// OrganizationAccessDeniedException error for a few minutes, even
-		// after succeeding a few requests.
-		switch r.Operation.Name {
-		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
-			if !isAWSErr(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
-				return
-			}
+	// Explicit provider configuration takes precedence over the
+	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+	proxyConfig := httpproxy.FromEnvironment()
 
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
-			}
-		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
-			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
-				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceInUseException) {
-					r.Retryable = aws.Bool(true)
-				}
-				return
-			}
+	if c.HTTPProxy != "" {
+		proxyConfig.HTTPProxy = c.HTTPProxy
+	}
 
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
-			}
+	if c.HTTPSProxy != "" {
+		proxyConfig.HTTPSProxy = c.HTTPSProxy
+	}
+
+	if c.NoProxy != "" {
+		proxyConfig.NoProxy = c.NoProxy
+	}
+
+	proxyFunc := proxyConfig.ProxyFunc()
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.CustomCABundle != "" {
+		filename, err := homedir.Expand(c.CustomCABundle)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding custom CA bundle path (%s): %w", c.CustomCABundle, err)
 		}
-	})
 
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.dynamodbconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
+		pem, err := ioutil.ReadFile(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle (%s): %w", filename, err)
 		}
-		if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
+
+		// As with the AWS_CA_BUNDLE environment variable, the bundle
+		// replaces rather than extends the system certificate pool.
+		rootCAs := x509.NewCertPool()
+
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("error loading custom CA bundle (%s): no PEM encoded certificates found", filename)
 		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return client, nil
+}
+
+// sourceCredentials returns the credentials resolved by the provider, rather than by awsbase,
+// or nil if awsbase should resolve the credentials.
+func (c *Config) sourceCredentials(httpClient *http.Client) (*credentials.Credentials, error) {
+	switch {
+	case c.AssumeRoleWithWebIdentity != nil:
+		return c.webIdentityCredentials(httpClient)
+	case c.AccessKey != "" || c.SecretKey != "":
+		return nil, nil
+	case len(c.SharedConfigFiles) > 0 || len(c.SharedCredentialsFiles) > 0:
+		return c.sharedConfigCredentials(httpClient)
+	}
+
+	return nil, nil
+}
+
+// sharedConfigCredentials returns the credentials for the configured profile from the
+// shared config and credentials files, including profiles using credential_process,
+// sso_* or role_arn settings. Environment credentials take precedence when no profile is configured.
+func (c *Config) sharedConfigCredentials(httpClient *http.Client) (*credentials.Credentials, error) {
+	configFilenames, err := expandSharedConfigFilenames(c.SharedConfigFiles)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(configFilenames) == 0 {
+		configFilenames = []string{defaultSharedConfigFilename("AWS_CONFIG_FILE", defaults.SharedConfigFilename())}
+	}
+
+	credentialsFiles := c.SharedCredentialsFiles
+
+	// The deprecated shared_credentials_file argument.
+	if len(credentialsFiles) == 0 && c.CredsFilename != "" {
+		credentialsFiles = []string{c.CredsFilename}
+	}
+
+	credentialsFilenames, err := expandSharedConfigFilenames(credentialsFiles)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(credentialsFilenames) == 0 {
+		credentialsFilenames = []string{defaultSharedConfigFilename("AWS_SHARED_CREDENTIALS_FILE", defaults.SharedCredentialsFilename())}
+	}
+
+	// As with the AWS SDK defaults, values in credentials files take precedence over config files
+	// and, within each list, values in later files take precedence over earlier files.
+	filenames := append(configFilenames, credentialsFilenames...)
+
+	if httpClient == nil {
+		httpClient = c.defaultHTTPClient()
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			HTTPClient:                    httpClient,
+			MaxRetries:                    aws.Int(c.MaxRetries),
+			Region:                        aws.String(c.Region),
+		},
+		Profile:           c.Profile,
+		SharedConfigFiles: filenames,
+		SharedConfigState: session.SharedConfigEnable,
 	})
 
-	client.ec2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateClientVpnEndpoint" {
-			if isAWSErr(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
+	if err != nil {
+		return nil, fmt.Errorf("error loading shared configuration files (%s): %w", strings.Join(filenames, ", "), err)
+	}
 
-		if r.Operation.Name == "CreateVpnConnection" {
-			if isAWSErr(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
+	return sess.Config.Credentials, nil
+}
+
+// expandSharedConfigFilenames expands the specified shared config or credentials file paths,
+// returning an error if any file does not exist.
+func expandSharedConfigFilenames(paths []string) ([]string, error) {
+	var filenames []string
+
+	for _, path := range paths {
+		filename, err := homedir.Expand(path)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding shared configuration file path (%s): %w", path, err)
 		}
 
-		if r.Operation.Name == "CreateVpnGateway" {
-			if isAWSErr(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
+		if _, err := os.Stat(filename); err != nil {
+			return nil, fmt.Errorf("error reading shared configuration file (%s): %w", filename, err)
 		}
 
-		if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
-			if isAWSErr(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
-				r.Retryable = aws.Bool(true)
-			}
+		filenames = append(filenames, filename)
+	}
+
+	return filenames, nil
+}
+
+// defaultSharedConfigFilename returns the value of the specified environment variable, or the default filename.
+func defaultSharedConfigFilename(envVar, defaultFilename string) string {
+	if v := os.Getenv(envVar); v != "" {
+		return v
+	}
+
+	return defaultFilename
+}
+
+// defaultHTTPClient returns an HTTP client equivalent to the one configured by awsbase.
+func (c *Config) defaultHTTPClient() *http.Client {
+	httpClient := cleanhttp.DefaultClient()
+
+	if c.Insecure {
+		httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return httpClient
+}
+
+// webIdentityCredentials returns refreshable credentials for the assume_role_with_web_identity configuration.
+func (c *Config) webIdentityCredentials(httpClient *http.Client) (*credentials.Credentials, error) {
+	v := c.AssumeRoleWithWebIdentity
+
+	if v.RoleARN == "" {
+		return nil, fmt.Errorf("assume_role_with_web_identity: role_arn is required")
+	}
+
+	var token stscreds.TokenFetcher
+
+	switch {
+	case v.WebIdentityToken != "" && v.WebIdentityTokenFile != "":
+		return nil, fmt.Errorf("assume_role_with_web_identity: only one of web_identity_token or web_identity_token_file can be specified")
+	case v.WebIdentityToken != "":
+		token = tfsts.WebIdentityToken(v.WebIdentityToken)
+	case v.WebIdentityTokenFile != "":
+		filename, err := homedir.Expand(v.WebIdentityTokenFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("assume_role_with_web_identity: error expanding web_identity_token_file path (%s): %w", v.WebIdentityTokenFile, err)
 		}
-	})
 
-	client.fmsconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Acceptance testing creates and deletes resources in quick succession.
-		// The FMS onboarding process into Organizations is opaque to consumers.
-		// Since we cannot reasonably check this status before receiving the error,
-		// set the operation as retryable.
-		switch r.Operation.Name {
-		case "AssociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
-				r.Retryable = aws.Bool(true)
-			}
-		case "DisassociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
-				r.Retryable = aws.Bool(true)
-			}
+		if err := tfsts.ValidateWebIdentityTokenFile(filename); err != nil {
+			return nil, fmt.Errorf("assume_role_with_web_identity: %w", err)
 		}
+
+		token = tfsts.WebIdentityTokenFile(filename)
+	default:
+		return nil, fmt.Errorf("assume_role_with_web_identity: one of web_identity_token or web_identity_token_file must be specified")
+	}
+
+	if httpClient == nil {
+		httpClient = c.defaultHTTPClient()
+	}
+
+	// AssumeRoleWithWebIdentity requests are not signed.
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(c.Endpoints["sts"]),
+		HTTPClient:  httpClient,
+		MaxRetries:  aws.Int(c.MaxRetries),
+		Region:      aws.String(c.Region),
 	})
 
-	client.kafkaconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
+	if err != nil {
+		return nil, fmt.Errorf("assume_role_with_web_identity: error creating session: %w", err)
+	}
+
+	var stsconn stsiface.STSAPI = sts.New(sess)
+
+	if v.Policy != "" {
+		stsconn = tfsts.WebIdentityPolicyClient(stsconn, v.Policy)
+	}
+
+	provider := stscreds.NewWebIdentityRoleProviderWithOptions(stsconn, v.RoleARN, v.SessionName, token, func(p *stscreds.WebIdentityRoleProvider) {
+		p.Duration = v.Duration
+		p.ExpiryWindow = 5 * time.Minute
+
+		for _, policyARN := range v.PolicyARNs {
+			p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{
+				Arn: aws.String(policyARN),
+			})
 		}
 	})
 
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
+	return credentials.NewCredentials(provider), nil
+}
+
+// assumeRoleCredentials returns refreshable credentials for the assume_role configuration,
+// using the credentials from the specified session as the source identity.
+func (c *Config) assumeRoleCredentials(sess *session.Session) *credentials.Credentials {
+	stsconn := sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])}))
+
+	return stscreds.NewCredentialsWithClient(stsconn, c.AssumeRoleARN, func(p *stscreds.AssumeRoleProvider) {
+		if c.AssumeRoleDurationSeconds > 0 {
+			p.Duration = time.Duration(c.AssumeRoleDurationSeconds) * time.Second
 		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
+
+		if c.AssumeRoleExternalID != "" {
+			p.ExternalID = aws.String(c.AssumeRoleExternalID)
 		}
-	})
 
-	client.organizationsconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Retry on the following error:
-		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
-		if isAWSErr(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
-			r.Retryable = aws.Bool(true)
+		if c.AssumeRolePolicy != "" {
+			p.Policy = aws.String(c.AssumeRolePolicy)
 		}
-	})
 
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
-	client.securityhubconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch r.Operation.Name {
-		case "EnableOrganizationAdminAccount":
-			if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
+		for _, policyARN := range c.AssumeRolePolicyARNs {
+			p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{
+				Arn: aws.String(policyARN),
+			})
 		}
-	})
 
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
-	client.ssoadminconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
-			if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
+		if c.AssumeRoleSessionName != "" {
+			p.RoleSessionName = c.AssumeRoleSessionName
 		}
-	})
 
-	client.storagegatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if isAWSErr(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
+		for k, v := range c.AssumeRoleTags {
+			p.Tags = append(p.Tags, &sts.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
 		}
+
+		p.TransitiveTagKeys = aws.StringSlice(c.AssumeRoleTransitiveTagKeys)
 	})
+}
+
+// assumedRoleARNs returns the ARNs of any IAM Roles assumed by the provider, last assumed first.
+func (c *Config) assumedRoleARNs() []string {
+	var roleARNs []string
 
-	client.wafv2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
-			r.Retryable = aws.Bool(true)
+	if c.AssumeRoleARN != "" {
+		roleARNs = append(roleARNs, c.AssumeRoleARN)
+	}
+
+	if c.AssumeRoleWithWebIdentity != nil && c.AssumeRoleWithWebIdentity.RoleARN != "" {
+		roleARNs = append(roleARNs, c.AssumeRoleWithWebIdentity.RoleARN)
+	}
+
+	return roleARNs
+}
+
+// accountIDAndPartition validates the session credentials and returns the AWS account ID and partition.
+// It is only used for credentials resolved by the provider, which awsbase does not validate.
+func (c *Config) accountIDAndPartition(sess *session.Session) (string, string, error) {
+	iamconn := iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])}))
+	stsconn := sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])}))
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsconn)
+
+		if err != nil {
+			return "", "", fmt.Errorf("error validating provider credentials: %w", err)
 		}
 
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
-			r.Retryable = aws.Bool(true)
+		return accountID, partition, nil
+	}
+
+	for _, roleARN := range c.assumedRoleARNs() {
+		if v, err := arn.Parse(roleARN); err == nil {
+			return v.AccountID, v.Partition, nil
 		}
+	}
 
-		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
-			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
-			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
-			if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-			if isAWSErr(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
+	if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if v, err := sess.Config.Credentials.Get(); err == nil {
+			credentialsProviderName = v.ProviderName
 		}
-	})
 
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iamconn, stsconn, credentialsProviderName)
+
 		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.supportedplatforms = supportedPlatforms
+			return "", "", fmt.Errorf("AWS account ID not previously found and failed retrieving via all available methods. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. Errors: %w", err)
 		}
+
+		return accountID, partition, nil
 	}
 
-	return client, nil
+	var partition string
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return "", partition, nil
 }
 
 func hasEc2Classic(platforms []string) bool {
@@ -1,11 +1,23 @@
 package aws
 
 import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	tfendpoints "github.com/terraform-providers/terraform-provider-aws/aws/internal/endpoints"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) {
@@ -69,6 +81,16 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 			Prefix:   "test",
 			Expected: "test.cn-northwest-1.amazonaws.com.cn", //lintignore:AWSAT003
 		},
+		{
+			Name: "AWS Commercial FIPS and DualStack",
+			AWSClient: &AWSClient{
+				dnsSuffix:       "amazonaws.com",
+				endpointVariant: tfendpoints.Variant{DualStack: true, FIPS: true},
+				region:          "us-west-2", //lintignore:AWSAT003
+			},
+			Prefix:   "test",
+			Expected: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -82,6 +104,678 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestAWSClientS3RegionalHostname(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Prefix    string
+		Expected  string
+	}{
+		{
+			Name: "AWS Commercial",
+			AWSClient: &AWSClient{
+				dnsSuffix: "amazonaws.com",
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Prefix:   "test.s3-accesspoint",
+			Expected: "test.s3-accesspoint.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name: "AWS Commercial FIPS and DualStack",
+			AWSClient: &AWSClient{
+				dnsSuffix:       "amazonaws.com",
+				endpointVariant: tfendpoints.Variant{DualStack: true, FIPS: true},
+				region:          "us-west-2", //lintignore:AWSAT003
+			},
+			Prefix:   "test.s3-accesspoint",
+			Expected: "test.s3-accesspoint-fips.dualstack.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.AWSClient.S3RegionalHostname(testCase.Prefix)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigHTTPClient(t *testing.T) {
+	testConfigUnsetProxyEnv(t)
+
+	client, err := (&Config{}).httpClient()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if client != nil {
+		t.Errorf("expected no HTTP client customization, got %#v", client)
+	}
+}
+
+func TestConfigHTTPClientProxy(t *testing.T) {
+	testConfigUnsetProxyEnv(t)
+
+	testCases := []struct {
+		Name          string
+		Config        *Config
+		URL           string
+		ExpectedProxy string
+	}{
+		{
+			Name:          "http_proxy HTTP request",
+			Config:        &Config{HTTPProxy: "http://proxy.example.com:3128"},
+			URL:           "http://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			ExpectedProxy: "http://proxy.example.com:3128",
+		},
+		{
+			Name:          "http_proxy HTTPS request",
+			Config:        &Config{HTTPProxy: "http://proxy.example.com:3128"},
+			URL:           "https://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			ExpectedProxy: "",
+		},
+		{
+			Name:          "https_proxy HTTPS request",
+			Config:        &Config{HTTPSProxy: "http://secure-proxy.example.com:3128"},
+			URL:           "https://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			ExpectedProxy: "http://secure-proxy.example.com:3128",
+		},
+		{
+			Name:          "no_proxy match",
+			Config:        &Config{HTTPSProxy: "http://secure-proxy.example.com:3128", NoProxy: "example.net,.amazonaws.com"},
+			URL:           "https://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			ExpectedProxy: "",
+		},
+		{
+			Name:          "no_proxy no match",
+			Config:        &Config{HTTPSProxy: "http://secure-proxy.example.com:3128", NoProxy: "example.net"},
+			URL:           "https://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			ExpectedProxy: "http://secure-proxy.example.com:3128",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client, err := testCase.Config.httpClient()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			req, err := http.NewRequest(http.MethodGet, testCase.URL, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			proxyURL, err := client.Transport.(*http.Transport).Proxy(req)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := ""
+
+			if proxyURL != nil {
+				got = proxyURL.String()
+			}
+
+			if got != testCase.ExpectedProxy {
+				t.Errorf("got proxy %q, expected %q", got, testCase.ExpectedProxy)
+			}
+		})
+	}
+}
+
+func TestConfigHTTPClientProxyServer(t *testing.T) {
+	testConfigUnsetProxyEnv(t)
+
+	var proxiedURL string
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURL = r.URL.String()
+		fmt.Fprint(w, "proxied")
+	}))
+	defer proxy.Close()
+
+	client, err := (&Config{HTTPProxy: proxy.URL}).httpClient()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := client.Get("http://ec2.us-west-2.amazonaws.com/") //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := string(body), "proxied"; got != expected {
+		t.Errorf("got response %q, expected %q", got, expected)
+	}
+
+	if got, expected := proxiedURL, "http://ec2.us-west-2.amazonaws.com/"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got proxied URL %q, expected %q", got, expected)
+	}
+}
+
+func TestConfigHTTPClientCustomCABundle(t *testing.T) {
+	testConfigUnsetProxyEnv(t)
+
+	caKey := tlsRsaPrivateKeyPem(2048)
+	caCertificate := tlsRsaX509SelfSignedCaCertificatePem(caKey)
+	key := tlsRsaPrivateKeyPem(2048)
+	certificate := tlsRsaX509LocallySignedCertificatePem(caKey, caCertificate, key, "localhost")
+
+	serverCertificate, err := tls.X509KeyPair([]byte(certificate), []byte(key))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{serverCertificate}}
+	server.StartTLS()
+	defer server.Close()
+
+	serverURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+
+	dir := t.TempDir()
+	caBundle := filepath.Join(dir, "ca-bundle.pem")
+	invalidCABundle := filepath.Join(dir, "invalid-ca-bundle.pem")
+
+	if err := ioutil.WriteFile(caBundle, []byte(caCertificate), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := ioutil.WriteFile(invalidCABundle, []byte("not a certificate"), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name               string
+		Config             *Config
+		ExpectConfigError  bool
+		ExpectRequestError bool
+	}{
+		{
+			Name:   "custom CA bundle",
+			Config: &Config{CustomCABundle: caBundle},
+		},
+		{
+			Name:               "no custom CA bundle",
+			Config:             &Config{NoProxy: "localhost"},
+			ExpectRequestError: true,
+		},
+		{
+			Name:   "no custom CA bundle insecure",
+			Config: &Config{Insecure: true, NoProxy: "localhost"},
+		},
+		{
+			Name:              "invalid custom CA bundle",
+			Config:            &Config{CustomCABundle: invalidCABundle},
+			ExpectConfigError: true,
+		},
+		{
+			Name:              "missing custom CA bundle",
+			Config:            &Config{CustomCABundle: filepath.Join(dir, "missing.pem")},
+			ExpectConfigError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client, err := testCase.Config.httpClient()
+
+			if testCase.ExpectConfigError {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp, err := client.Get(serverURL)
+
+			if testCase.ExpectRequestError {
+				if err == nil {
+					resp.Body.Close()
+					t.Fatalf("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("got status code %d, expected %d", resp.StatusCode, http.StatusOK)
+			}
+		})
+	}
+}
+
+func TestConfigWebIdentityCredentials(t *testing.T) {
+	server := testConfigStsServer(t)
+	defer server.Close()
+
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	emptyTokenFile := filepath.Join(dir, "empty-token")
+
+	if err := ioutil.WriteFile(tokenFile, []byte(testConfigWebIdentityToken+"\n"), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := ioutil.WriteFile(emptyTokenFile, []byte{}, 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name                      string
+		AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
+		ExpectConfigError         bool
+		ExpectCredentialsError    bool
+	}{
+		{
+			Name: "web identity token",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:          "arn:aws:iam::123456789012:role/WebIdentity", //lintignore:AWSAT005
+				WebIdentityToken: testConfigWebIdentityToken,
+			},
+		},
+		{
+			Name: "web identity token file",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				Duration:             time.Hour,
+				PolicyARNs:           []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"}, //lintignore:AWSAT005
+				RoleARN:              "arn:aws:iam::123456789012:role/WebIdentity",       //lintignore:AWSAT005
+				SessionName:          "ci",
+				WebIdentityTokenFile: tokenFile,
+			},
+		},
+		{
+			Name: "policy",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				Policy:           `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				RoleARN:          "arn:aws:iam::123456789012:role/WebIdentity", //lintignore:AWSAT005
+				WebIdentityToken: testConfigWebIdentityToken,
+			},
+		},
+		{
+			Name: "invalid policy",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				Policy:           "{",
+				RoleARN:          "arn:aws:iam::123456789012:role/WebIdentity", //lintignore:AWSAT005
+				WebIdentityToken: testConfigWebIdentityToken,
+			},
+			ExpectCredentialsError: true,
+		},
+		{
+			Name: "invalid web identity token",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:          "arn:aws:iam::123456789012:role/WebIdentity", //lintignore:AWSAT005
+				WebIdentityToken: "invalid",
+			},
+			ExpectCredentialsError: true,
+		},
+		{
+			Name: "missing web identity token file",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:              "arn:aws:iam::123456789012:role/WebIdentity", //lintignore:AWSAT005
+				WebIdentityTokenFile: filepath.Join(dir, "missing"),
+			},
+			ExpectConfigError: true,
+		},
+		{
+			Name: "empty web identity token file",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:              "arn:aws:iam::123456789012:role/WebIdentity", //lintignore:AWSAT005
+				WebIdentityTokenFile: emptyTokenFile,
+			},
+			ExpectConfigError: true,
+		},
+		{
+			Name: "directory web identity token file",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:              "arn:aws:iam::123456789012:role/WebIdentity", //lintignore:AWSAT005
+				WebIdentityTokenFile: dir,
+			},
+			ExpectConfigError: true,
+		},
+		{
+			Name: "no web identity token",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN: "arn:aws:iam::123456789012:role/WebIdentity", //lintignore:AWSAT005
+			},
+			ExpectConfigError: true,
+		},
+		{
+			Name: "no role ARN",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				WebIdentityToken: testConfigWebIdentityToken,
+			},
+			ExpectConfigError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &Config{
+				AssumeRoleWithWebIdentity: testCase.AssumeRoleWithWebIdentity,
+				Endpoints:                 map[string]string{"sts": server.URL},
+				Region:                    "us-west-2", //lintignore:AWSAT003
+			}
+
+			creds, err := config.webIdentityCredentials(nil)
+
+			if testCase.ExpectConfigError {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			v, err := creds.Get()
+
+			if testCase.ExpectCredentialsError {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := v.AccessKeyID, testConfigWebIdentityAccessKeyID; got != expected {
+				t.Errorf("got access key ID %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigClientAssumeRoleWithWebIdentityChained(t *testing.T) {
+	server := testConfigStsServer(t)
+	defer server.Close()
+
+	config := &Config{
+		AssumeRoleARN: "arn:aws:iam::210987654321:role/Chained", //lintignore:AWSAT005
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:          "arn:aws:iam::123456789012:role/WebIdentity", //lintignore:AWSAT005
+			WebIdentityToken: testConfigWebIdentityToken,
+		},
+		Endpoints: map[string]string{
+			"iam": server.URL,
+			"sts": server.URL,
+		},
+		MaxRetries:              1,
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, expected := client.accountid, "210987654321"; got != expected {
+		t.Errorf("got account ID %q, expected %q", got, expected)
+	}
+
+	v, err := client.stsconn.Config.Credentials.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := v.AccessKeyID, testConfigAssumeRoleAccessKeyID; got != expected {
+		t.Errorf("got access key ID %q, expected %q", got, expected)
+	}
+}
+
+const (
+	testConfigWebIdentityToken       = "eyJhbGciOiJSUzI1NiJ9.test.signature"
+	testConfigWebIdentityAccessKeyID = "ASIAWEBIDENTITYEXAMPLE"
+	testConfigAssumeRoleAccessKeyID  = "ASIAASSUMEROLEEXAMPLE"
+	testConfigStsErrorResponse       = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><Error><Type>Sender</Type><Code>%[1]s</Code><Message>%[2]s</Message></Error><RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId></ErrorResponse>`
+	testConfigStsCredentialsResponse = `<%[1]sResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><%[1]sResult><AssumedRoleUser><Arn>%[2]s/session</Arn><AssumedRoleId>AROA01234567890EXAMPLE:session</AssumedRoleId></AssumedRoleUser><Credentials><AccessKeyId>%[3]s</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken><Expiration>%[4]s</Expiration></Credentials></%[1]sResult><ResponseMetadata><RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId></ResponseMetadata></%[1]sResponse>`
+)
+
+// testConfigStsServer returns a stub STS endpoint implementing AssumeRoleWithWebIdentity and AssumeRole.
+// AssumeRole requests must be signed with the credentials returned by AssumeRoleWithWebIdentity.
+func testConfigStsServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing STS request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		action := r.Form.Get("Action")
+
+		switch action {
+		case "AssumeRoleWithWebIdentity":
+			if r.Form.Get("WebIdentityToken") != testConfigWebIdentityToken {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, testConfigStsErrorResponse, "InvalidIdentityToken", "Couldn't retrieve verification key from your identity provider")
+				return
+			}
+
+			if v := r.Form.Get("Policy"); v != "" && !json.Valid([]byte(v)) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, testConfigStsErrorResponse, "MalformedPolicyDocument", "The policy is not in the valid JSON format.")
+				return
+			}
+
+			fmt.Fprintf(w, testConfigStsCredentialsResponse, action, r.Form.Get("RoleArn"), testConfigWebIdentityAccessKeyID, expiration)
+		case "AssumeRole":
+			if !strings.Contains(r.Header.Get("Authorization"), "Credential="+testConfigWebIdentityAccessKeyID+"/") {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, testConfigStsErrorResponse, "AccessDenied", "Not authorized to perform sts:AssumeRole")
+				return
+			}
+
+			fmt.Fprintf(w, testConfigStsCredentialsResponse, action, r.Form.Get("RoleArn"), testConfigAssumeRoleAccessKeyID, expiration)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, testConfigStsErrorResponse, "InvalidAction", action)
+		}
+	}))
+}
+
+// testConfigUnsetProxyEnv unsets any proxy environment variables for the duration of the test.
+func testConfigUnsetProxyEnv(t *testing.T) {
+	testConfigUnsetEnv(t, "HTTP_PROXY", "http_proxy", "HTTPS_PROXY", "https_proxy", "NO_PROXY", "no_proxy", "REQUEST_METHOD")
+}
+
+// testConfigUnsetEnv unsets the specified environment variables for the duration of the test.
+func testConfigUnsetEnv(t *testing.T, keys ...string) {
+	for _, key := range keys {
+		key := key
+
+		if value, ok := os.LookupEnv(key); ok {
+			os.Unsetenv(key)
+			t.Cleanup(func() {
+				os.Setenv(key, value)
+			})
+		}
+	}
+}
+
+func TestConfigSharedConfigCredentials(t *testing.T) {
+	testConfigUnsetEnv(t, "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "AWS_PROFILE", "AWS_DEFAULT_PROFILE",
+		"AWS_CONFIG_FILE", "AWS_SHARED_CREDENTIALS_FILE", "AWS_SDK_LOAD_CONFIG")
+
+	dir := t.TempDir()
+
+	testConfigWriteFile := func(t *testing.T, name, content string) string {
+		filename := filepath.Join(dir, name)
+
+		if err := ioutil.WriteFile(filename, []byte(content), 0600); err != nil {
+			t.Fatalf("error writing %s: %s", filename, err)
+		}
+
+		return filename
+	}
+
+	configFile1 := testConfigWriteFile(t, "config1", `
+[profile process]
+credential_process = echo '{"Version":1,"AccessKeyId":"ProcessAccessKey","SecretAccessKey":"ProcessSecretKey"}'
+`)
+	configFile2 := testConfigWriteFile(t, "config2", `
+[profile second]
+region = us-west-2
+`)
+	credentialsFile1 := testConfigWriteFile(t, "credentials1", `
+[default]
+aws_access_key_id = DefaultAccessKey1
+aws_secret_access_key = DefaultSecretKey1
+
+[first]
+aws_access_key_id = FirstAccessKey
+aws_secret_access_key = FirstSecretKey
+`)
+	credentialsFile2 := testConfigWriteFile(t, "credentials2", `
+[default]
+aws_access_key_id = DefaultAccessKey2
+aws_secret_access_key = DefaultSecretKey2
+
+[second]
+aws_access_key_id = SecondAccessKey
+aws_secret_access_key = SecondSecretKey
+`)
+
+	testCases := []struct {
+		Name                string
+		Config              *Config
+		ExpectedAccessKeyID string
+		ExpectedError       bool
+	}{
+		{
+			Name: "profile in first credentials file",
+			Config: &Config{
+				Profile:                "first",
+				SharedCredentialsFiles: []string{credentialsFile1, credentialsFile2},
+			},
+			ExpectedAccessKeyID: "FirstAccessKey",
+		},
+		{
+			Name: "profile in second credentials file",
+			Config: &Config{
+				Profile:                "second",
+				SharedConfigFiles:      []string{configFile1, configFile2},
+				SharedCredentialsFiles: []string{credentialsFile1, credentialsFile2},
+			},
+			ExpectedAccessKeyID: "SecondAccessKey",
+		},
+		{
+			Name: "later credentials file takes precedence",
+			Config: &Config{
+				SharedCredentialsFiles: []string{credentialsFile1, credentialsFile2},
+			},
+			ExpectedAccessKeyID: "DefaultAccessKey2",
+		},
+		{
+			Name: "deprecated shared credentials file",
+			Config: &Config{
+				CredsFilename: credentialsFile1,
+			},
+			ExpectedAccessKeyID: "DefaultAccessKey1",
+		},
+		{
+			Name: "credential process",
+			Config: &Config{
+				Profile:                "process",
+				SharedConfigFiles:      []string{configFile1},
+				SharedCredentialsFiles: []string{credentialsFile1},
+			},
+			ExpectedAccessKeyID: "ProcessAccessKey",
+		},
+		{
+			Name: "missing profile",
+			Config: &Config{
+				Profile:                "missing",
+				SharedConfigFiles:      []string{configFile1, configFile2},
+				SharedCredentialsFiles: []string{credentialsFile1, credentialsFile2},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "missing file",
+			Config: &Config{
+				SharedCredentialsFiles: []string{credentialsFile1, filepath.Join(dir, "missing")},
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Config.Region = "us-east-1" //lintignore:AWSAT003
+
+			creds, err := testCase.Config.sharedConfigCredentials(nil)
+
+			if err == nil {
+				var value credentials.Value
+
+				value, err = creds.Get()
+
+				if err == nil && value.AccessKeyID != testCase.ExpectedAccessKeyID {
+					t.Errorf("got access key ID %q, expected %q", value.AccessKeyID, testCase.ExpectedAccessKeyID)
+				}
+			}
+
+			if testCase.ExpectedError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
@@ -34,6 +34,11 @@ func dataSourceAwsS3Bucket() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
 			"hosted_zone_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -55,6 +60,7 @@ func dataSourceAwsS3Bucket() *schema.Resource {
 }
 
 func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	conn := meta.(*AWSClient).s3conn
 
 	bucket := d.Get("bucket").(string)
@@ -63,6 +69,10 @@ func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		Bucket: aws.String(bucket),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] Reading S3 bucket: %s", input)
 	_, err := conn.HeadBucket(input)
 
@@ -79,12 +89,12 @@ func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", arn)
 	d.Set("bucket_domain_name", meta.(*AWSClient).PartitionHostname(fmt.Sprintf("%s.s3", bucket)))
 
-	err = bucketLocation(meta.(*AWSClient), d, bucket)
+	err = bucketLocation(meta.(*AWSClient), conn, d, bucket, expectedBucketOwner)
 	if err != nil {
 		return fmt.Errorf("error getting S3 Bucket location: %w", err)
 	}
 
-	regionalDomainName, err := BucketRegionalDomainName(bucket, d.Get("region").(string))
+	regionalDomainName, err := bucketRegionalDomainName(meta.(*AWSClient).EndpointResolver(), bucket, d.Get("region").(string))
 	if err != nil {
 		return err
 	}
@@ -93,19 +103,23 @@ func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func bucketLocation(client *AWSClient, d *schema.ResourceData, bucket string) error {
-	region, err := s3manager.GetBucketRegionWithClient(context.Background(), client.s3conn, bucket, func(r *request.Request) {
+func bucketLocation(client *AWSClient, conn *s3.S3, d *schema.ResourceData, bucket, expectedBucketOwner string) error {
+	region, err := s3manager.GetBucketRegionWithClient(context.Background(), conn, bucket, func(r *request.Request) {
 		// By default, GetBucketRegion forces virtual host addressing, which
 		// is not compatible with many non-AWS implementations. Instead, pass
 		// the provider s3_force_path_style configuration, which defaults to
 		// false, but allows override.
-		r.Config.S3ForcePathStyle = client.s3conn.Config.S3ForcePathStyle
+		r.Config.S3ForcePathStyle = conn.Config.S3ForcePathStyle
 
 		// By default, GetBucketRegion uses anonymous credentials when doing
 		// a HEAD request to get the bucket region. This breaks in aws-cn regions
 		// when the account doesn't have an ICP license to host public content.
 		// Use the current credentials when getting the bucket region.
-		r.Config.Credentials = client.s3conn.Config.Credentials
+		r.Config.Credentials = conn.Config.Credentials
+
+		if input, ok := r.Params.(*s3.HeadBucketInput); ok && expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
 	})
 	if err != nil {
 		return err
@@ -121,11 +135,15 @@ func bucketLocation(client *AWSClient, d *schema.ResourceData, bucket string) er
 		d.Set("hosted_zone_id", hostedZoneID)
 	}
 
-	_, websiteErr := client.s3conn.GetBucketWebsite(
-		&s3.GetBucketWebsiteInput{
-			Bucket: aws.String(bucket),
-		},
-	)
+	websiteInput := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		websiteInput.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, websiteErr := conn.GetBucketWebsite(websiteInput)
 
 	if websiteErr == nil {
 		websiteEndpoint := WebsiteEndpoint(client, bucket, region)
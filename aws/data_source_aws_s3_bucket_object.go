@@ -59,6 +59,11 @@ func dataSourceAwsS3BucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
 			"expiration": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -124,6 +129,7 @@ func dataSourceAwsS3BucketObject() *schema.Resource {
 }
 
 func dataSourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	conn := meta.(*AWSClient).s3conn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -140,6 +146,9 @@ func dataSourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) e
 	if v, ok := d.GetOk("version_id"); ok {
 		input.VersionId = aws.String(v.(string))
 	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
 
 	versionText := ""
 	uniqueId := bucket + "/" + key
@@ -204,6 +213,9 @@ func dataSourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) e
 		if out.VersionId != nil {
 			input.VersionId = out.VersionId
 		}
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
 		out, err := conn.GetObject(&input)
 		if err != nil {
 			return fmt.Errorf("Failed getting S3 object: %w", err)
@@ -227,7 +239,7 @@ func dataSourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) e
 		log.Printf("[INFO] Ignoring body of S3 object %s with Content-Type %q", uniqueId, contentType)
 	}
 
-	tags, err := keyvaluetags.S3ObjectListTags(conn, bucket, key)
+	tags, err := keyvaluetags.S3ObjectListTags(conn, bucket, key, expectedBucketOwner)
 
 	if err != nil {
 		return fmt.Errorf("error listing tags for S3 Bucket (%s) Object (%s): %w", bucket, key, err)
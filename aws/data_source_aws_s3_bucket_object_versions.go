@@ -0,0 +1,270 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAwsS3BucketObjectVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsS3BucketObjectVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"common_prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_markers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"is_latest": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"encoding_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.EncodingType_Values(), false),
+			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"key_marker": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"version_id_marker": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"key_marker"},
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_latest": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsS3BucketObjectVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	if v, ok := d.GetOk("delimiter"); ok {
+		input.Delimiter = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encoding_type"); ok {
+		input.EncodingType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key_marker"); ok {
+		input.KeyMarker = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefix"); ok {
+		input.Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("version_id_marker"); ok {
+		input.VersionIdMarker = aws.String(v.(string))
+	}
+
+	// As with aws_s3_bucket_objects, "input.MaxKeys" is the page size and
+	// "maxKeys" is the total number of versions and delete markers returned.
+	maxKeys := int64(d.Get("max_keys").(int))
+	if maxKeys <= keyRequestPageSize {
+		input.MaxKeys = aws.Int64(maxKeys)
+	}
+
+	var commonPrefixes []string
+	var deleteMarkers []interface{}
+	var versions []interface{}
+
+	err := conn.ListObjectVersionsPages(input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		for _, commonPrefix := range page.CommonPrefixes {
+			commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
+		}
+
+		for _, deleteMarker := range page.DeleteMarkers {
+			deleteMarkers = append(deleteMarkers, flattenS3DeleteMarkerEntry(deleteMarker))
+		}
+
+		for _, version := range page.Versions {
+			versions = append(versions, flattenS3ObjectVersion(version))
+		}
+
+		maxKeys = maxKeys - int64(len(page.DeleteMarkers)+len(page.Versions))
+
+		if maxKeys <= 0 {
+			return false
+		}
+
+		if maxKeys <= keyRequestPageSize {
+			input.MaxKeys = aws.Int64(maxKeys)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Bucket (%s) Object Versions: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	if err := d.Set("common_prefixes", commonPrefixes); err != nil {
+		return fmt.Errorf("error setting common_prefixes: %w", err)
+	}
+
+	if err := d.Set("delete_markers", deleteMarkers); err != nil {
+		return fmt.Errorf("error setting delete_markers: %w", err)
+	}
+
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("error setting versions: %w", err)
+	}
+
+	return nil
+}
+
+func flattenS3DeleteMarkerEntry(apiObject *s3.DeleteMarkerEntry) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"is_latest":  aws.BoolValue(apiObject.IsLatest),
+		"key":        aws.StringValue(apiObject.Key),
+		"version_id": aws.StringValue(apiObject.VersionId),
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = aws.TimeValue(v).Format(time.RFC1123)
+	}
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = aws.StringValue(v.ID)
+	}
+
+	return tfMap
+}
+
+func flattenS3ObjectVersion(apiObject *s3.ObjectVersion) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"etag":          strings.Trim(aws.StringValue(apiObject.ETag), `"`),
+		"is_latest":     aws.BoolValue(apiObject.IsLatest),
+		"key":           aws.StringValue(apiObject.Key),
+		"size":          aws.Int64Value(apiObject.Size),
+		"storage_class": aws.StringValue(apiObject.StorageClass),
+		"version_id":    aws.StringValue(apiObject.VersionId),
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = aws.TimeValue(v).Format(time.RFC1123)
+	}
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = aws.StringValue(v.ID)
+	}
+
+	return tfMap
+}
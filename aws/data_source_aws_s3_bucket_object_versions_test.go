@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSS3BucketObjectVersions_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_s3_bucket_object_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, s3.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3BucketObjectVersionsConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "delete_markers.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.key", "test-key1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.is_latest", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0.etag", "aws_s3_bucket_object.test1", "etag"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0.version_id", "aws_s3_bucket_object.test1", "version_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.0.last_modified"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.0.owner"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.size", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.storage_class", "STANDARD"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.1.key", "test-key2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjectVersions_maxKeys(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_s3_bucket_object_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, s3.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3BucketObjectVersionsConfigMaxKeys(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.key", "test-key1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSourceS3BucketObjectVersionsConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_object" "test1" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "test-key1"
+  content = "test1"
+}
+
+resource "aws_s3_bucket_object" "test2" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "test-key2"
+  content = "test2"
+}
+`, rName)
+}
+
+func testAccAWSDataSourceS3BucketObjectVersionsConfigBasic(rName string) string {
+	return composeConfig(testAccAWSDataSourceS3BucketObjectVersionsConfigBase(rName), `
+data "aws_s3_bucket_object_versions" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  depends_on = [aws_s3_bucket_object.test1, aws_s3_bucket_object.test2]
+}
+`)
+}
+
+func testAccAWSDataSourceS3BucketObjectVersionsConfigMaxKeys(rName string) string {
+	return composeConfig(testAccAWSDataSourceS3BucketObjectVersionsConfigBase(rName), `
+data "aws_s3_bucket_object_versions" "test" {
+  bucket   = aws_s3_bucket.test.id
+  max_keys = 1
+
+  depends_on = [aws_s3_bucket_object.test1, aws_s3_bucket_object.test2]
+}
+`)
+}
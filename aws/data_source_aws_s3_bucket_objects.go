@@ -2,6 +2,8 @@ package aws
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -40,10 +42,19 @@ func dataSourceAwsS3BucketObjects() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
 			"fetch_owner": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"fetch_metadata": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"keys": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -59,11 +70,44 @@ func dataSourceAwsS3BucketObjects() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func dataSourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	conn := meta.(*AWSClient).s3conn
 
 	bucket := d.Get("bucket").(string)
@@ -101,9 +145,16 @@ func dataSourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{})
 		listInput.FetchOwner = aws.Bool(b.(bool))
 	}
 
+	if expectedBucketOwner != "" {
+		listInput.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	fetchMetadata := d.Get("fetch_metadata").(bool)
+
 	var commonPrefixes []string
 	var keys []string
 	var owners []string
+	var objects []interface{}
 
 	err := conn.ListObjectsV2Pages(&listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
 		for _, commonPrefix := range page.CommonPrefixes {
@@ -116,10 +167,18 @@ func dataSourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{})
 			if object.Owner != nil {
 				owners = append(owners, aws.StringValue(object.Owner.ID))
 			}
+
+			if fetchMetadata {
+				objects = append(objects, flattenS3Object(object))
+			}
 		}
 
 		maxKeys = maxKeys - aws.Int64Value(page.KeyCount)
 
+		if maxKeys <= 0 {
+			return false
+		}
+
 		if maxKeys <= keyRequestPageSize {
 			listInput.MaxKeys = aws.Int64(maxKeys)
 		}
@@ -145,5 +204,32 @@ func dataSourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error setting owners: %w", err)
 	}
 
+	if err := d.Set("objects", objects); err != nil {
+		return fmt.Errorf("error setting objects: %w", err)
+	}
+
 	return nil
 }
+
+func flattenS3Object(apiObject *s3.Object) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"etag":          strings.Trim(aws.StringValue(apiObject.ETag), `"`),
+		"key":           aws.StringValue(apiObject.Key),
+		"size":          aws.Int64Value(apiObject.Size),
+		"storage_class": aws.StringValue(apiObject.StorageClass),
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = aws.TimeValue(v).Format(time.RFC1123)
+	}
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = aws.StringValue(v.ID)
+	}
+
+	return tfMap
+}
@@ -225,6 +225,40 @@ func TestAccDataSourceAWSS3BucketObjects_fetchOwner(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAWSS3BucketObjects_fetchMetadata(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.aws_s3_bucket_objects.yesh"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		ErrorCheck:                testAccErrorCheck(t, s3.EndpointsID),
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigMetadata(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsS3ObjectsDataSourceExists(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.key", "arch/three_gossips/broken"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "objects.0.etag", "aws_s3_bucket_object.object2", "etag"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.last_modified"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.owner"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.size", "10"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.storage_class", "STANDARD"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.key", "arch/three_gossips/turret"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.size", "8"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsS3ObjectsDataSourceExists(addr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[addr]
@@ -400,3 +434,16 @@ data "aws_s3_bucket_objects" "yesh" {
 }
 `, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
 }
+
+func testAccAWSDataSourceS3ObjectsConfigMetadata(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket         = aws_s3_bucket.objects_bucket.id
+  prefix         = "arch/three_gossips/"
+  fetch_owner    = true
+  fetch_metadata = true
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
@@ -56,6 +56,26 @@ func TestAccDataSourceS3Bucket_website(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceS3Bucket_expectedBucketOwner(t *testing.T) {
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, s3.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3BucketConfig_expectedBucketOwner(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("data.aws_s3_bucket.bucket"),
+					resource.TestCheckResourceAttrPair("data.aws_s3_bucket.bucket", "arn", "aws_s3_bucket.bucket", "arn"),
+					testAccCheckResourceAttrAccountID("data.aws_s3_bucket.bucket", "expected_bucket_owner"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAWSDataSourceS3BucketConfig_basic(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -85,3 +105,18 @@ data "aws_s3_bucket" "bucket" {
 }
 `, bucketName)
 }
+
+func testAccAWSDataSourceS3BucketConfig_expectedBucketOwner(bucketName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket = %[1]q
+}
+
+data "aws_s3_bucket" "bucket" {
+  bucket                = aws_s3_bucket.bucket.id
+  expected_bucket_owner = data.aws_caller_identity.current.account_id
+}
+`, bucketName)
+}
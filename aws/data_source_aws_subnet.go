@@ -51,6 +51,18 @@ func dataSourceAwsSubnet() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"enable_dns64": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"enable_resource_name_dns_aaaa_record_on_launch": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"enable_resource_name_dns_a_record_on_launch": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"filter": ec2CustomFiltersSchema(),
 			"id": {
 				Type:     schema.TypeString,
@@ -66,6 +78,10 @@ func dataSourceAwsSubnet() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ipv6_native": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"map_customer_owned_ip_on_launch": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -82,6 +98,10 @@ func dataSourceAwsSubnet() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"private_dns_hostname_type_on_launch": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -173,6 +193,8 @@ func dataSourceAwsSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("cidr_block", subnet.CidrBlock)
 	d.Set("customer_owned_ipv4_pool", subnet.CustomerOwnedIpv4Pool)
 	d.Set("default_for_az", subnet.DefaultForAz)
+	d.Set("enable_dns64", subnet.EnableDns64)
+	d.Set("ipv6_native", subnet.Ipv6Native)
 
 	for _, a := range subnet.Ipv6CidrBlockAssociationSet {
 		if a.Ipv6CidrBlockState != nil && aws.StringValue(a.Ipv6CidrBlockState.State) == ec2.VpcCidrBlockStateCodeAssociated { //we can only ever have 1 IPv6 block associated at once
@@ -185,6 +207,13 @@ func dataSourceAwsSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("map_public_ip_on_launch", subnet.MapPublicIpOnLaunch)
 	d.Set("outpost_arn", subnet.OutpostArn)
 	d.Set("owner_id", subnet.OwnerId)
+
+	if subnet.PrivateDnsNameOptionsOnLaunch != nil {
+		d.Set("enable_resource_name_dns_aaaa_record_on_launch", subnet.PrivateDnsNameOptionsOnLaunch.EnableResourceNameDnsAAAARecord)
+		d.Set("enable_resource_name_dns_a_record_on_launch", subnet.PrivateDnsNameOptionsOnLaunch.EnableResourceNameDnsARecord)
+		d.Set("private_dns_hostname_type_on_launch", subnet.PrivateDnsNameOptionsOnLaunch.HostnameType)
+	}
+
 	d.Set("state", subnet.State)
 
 	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(subnet.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAwsVpcIpamPreviewNextCidr() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsVpcIpamPreviewNextCidrRead,
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disallowed_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+		},
+	}
+}
+
+func dataSourceAwsVpcIpamPreviewNextCidrRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	poolID := d.Get("ipam_pool_id").(string)
+	input := &ec2.AllocateIpamPoolCidrInput{
+		IpamPoolId:      aws.String(poolID),
+		PreviewNextCidr: aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk("disallowed_cidrs"); ok && v.(*schema.Set).Len() > 0 {
+		input.DisallowedCidrs = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("netmask_length"); ok {
+		input.NetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Previewing next EC2 VPC IPAM Pool CIDR: %s", input)
+	output, err := conn.AllocateIpamPoolCidr(input)
+
+	if err != nil {
+		return fmt.Errorf("error previewing next EC2 VPC IPAM Pool (%s) CIDR: %w", poolID, err)
+	}
+
+	if output == nil || output.IpamPoolAllocation == nil {
+		return fmt.Errorf("error previewing next EC2 VPC IPAM Pool (%s) CIDR: empty result", poolID)
+	}
+
+	d.SetId(aws.StringValue(output.IpamPoolAllocation.IpamPoolAllocationId))
+	d.Set("cidr", output.IpamPoolAllocation.Cidr)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsVpcIpamPreviewNextCidr_ipv4Basic(t *testing.T) {
+	dataSourceName := "data.aws_vpc_ipam_preview_next_cidr.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcIpamPreviewNextCidrConfigIpv4Basic(28),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cidr", "172.2.0.0/28"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "netmask_length", "28"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsVpcIpamPreviewNextCidr_ipv4DisallowedCidr(t *testing.T) {
+	dataSourceName := "data.aws_vpc_ipam_preview_next_cidr.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcIpamPreviewNextCidrConfigIpv4DisallowedCidr(28, "172.2.0.0/28"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cidr", "172.2.0.16/28"),
+					resource.TestCheckResourceAttr(dataSourceName, "disallowed_cidrs.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "disallowed_cidrs.*", "172.2.0.0/28"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsVpcIpamPreviewNextCidrConfigBase = `
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+  locale         = data.aws_region.current.name
+}
+
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "172.2.0.0/24"
+}
+`
+
+func testAccDataSourceAwsVpcIpamPreviewNextCidrConfigIpv4Basic(netmaskLength int) string {
+	return composeConfig(testAccDataSourceAwsVpcIpamPreviewNextCidrConfigBase, fmt.Sprintf(`
+data "aws_vpc_ipam_preview_next_cidr" "test" {
+  ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  netmask_length = %[1]d
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, netmaskLength))
+}
+
+func testAccDataSourceAwsVpcIpamPreviewNextCidrConfigIpv4DisallowedCidr(netmaskLength int, disallowedCidr string) string {
+	return composeConfig(testAccDataSourceAwsVpcIpamPreviewNextCidrConfigBase, fmt.Sprintf(`
+data "aws_vpc_ipam_preview_next_cidr" "test" {
+  ipam_pool_id     = aws_vpc_ipam_pool.test.id
+  netmask_length   = %[1]d
+  disallowed_cidrs = [%[2]q]
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, netmaskLength, disallowedCidr))
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func dataSourceAwsVpcSecurityGroupRule() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsVpcSecurityGroupRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_ipv4": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_ipv6": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"from_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ip_protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_egress": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"referenced_security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_rule_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"to_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsVpcSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeSecurityGroupRulesInput{}
+
+	if v, ok := d.GetOk("security_group_rule_id"); ok {
+		input.SecurityGroupRuleIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	input.Filters = append(input.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	output, err := finder.SecurityGroupRule(conn, input)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("no matching EC2 VPC Security Group Rule found: %w", err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC Security Group Rule: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroupRuleId))
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: aws.StringValue(output.GroupOwnerId),
+		Resource:  fmt.Sprintf("security-group-rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("cidr_ipv4", output.CidrIpv4)
+	d.Set("cidr_ipv6", output.CidrIpv6)
+	d.Set("description", output.Description)
+	d.Set("from_port", output.FromPort)
+	d.Set("ip_protocol", output.IpProtocol)
+	d.Set("is_egress", output.IsEgress)
+	d.Set("prefix_list_id", output.PrefixListId)
+	if output.ReferencedGroupInfo != nil {
+		d.Set("referenced_security_group_id", output.ReferencedGroupInfo.GroupId)
+	} else {
+		d.Set("referenced_security_group_id", nil)
+	}
+	d.Set("security_group_id", output.GroupId)
+	d.Set("security_group_rule_id", output.SecurityGroupRuleId)
+	d.Set("to_port", output.ToPort)
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsVpcSecurityGroupRule_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_vpc_security_group_rule.test"
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcSecurityGroupRuleConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cidr_ipv4", resourceName, "cidr_ipv4"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cidr_ipv6", resourceName, "cidr_ipv6"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "from_port", resourceName, "from_port"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ip_protocol", resourceName, "ip_protocol"),
+					resource.TestCheckResourceAttr(dataSourceName, "is_egress", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "prefix_list_id", resourceName, "prefix_list_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "referenced_security_group_id", resourceName, "referenced_security_group_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_group_id", resourceName, "security_group_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_group_rule_id", resourceName, "security_group_rule_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "to_port", resourceName, "to_port"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsVpcSecurityGroupRule_Filter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_vpc_security_group_rule.test"
+	resourceName := "aws_vpc_security_group_egress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcSecurityGroupRuleConfigFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "is_egress", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_group_rule_id", resourceName, "security_group_rule_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsVpcSecurityGroupRuleConfigBasic(rName string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupIngressRuleConfig(rName), `
+data "aws_vpc_security_group_rule" "test" {
+  security_group_rule_id = aws_vpc_security_group_ingress_rule.test.id
+}
+`)
+}
+
+func testAccDataSourceAwsVpcSecurityGroupRuleConfigFilter(rName string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupEgressRuleConfig(rName), `
+data "aws_vpc_security_group_rule" "test" {
+  filter {
+    name   = "group-id"
+    values = [aws_security_group.test.id]
+  }
+
+  filter {
+    name   = "security-group-rule-id"
+    values = [aws_vpc_security_group_egress_rule.test.id]
+  }
+}
+`)
+}
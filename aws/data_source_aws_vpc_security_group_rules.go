@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+)
+
+func dataSourceAwsVpcSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsVpcSecurityGroupRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsVpcSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DescribeSecurityGroupRulesInput{}
+
+	input.Filters = append(input.Filters, buildEC2TagFilterList(
+		keyvaluetags.New(d.Get("tags").(map[string]interface{})).Ec2Tags(),
+	)...)
+
+	input.Filters = append(input.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	output, err := finder.SecurityGroupRules(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC Security Group Rules: %w", err)
+	}
+
+	var ids []string
+
+	for _, v := range output {
+		ids = append(ids, aws.StringValue(v.SecurityGroupRuleId))
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsVpcSecurityGroupRules_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcSecurityGroupRulesConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsVpcSecurityGroupRules_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcSecurityGroupRulesConfigTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "aws_vpc_security_group_ingress_rule.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsVpcSecurityGroupRulesConfigBase(rName string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+`, rName))
+}
+
+func testAccDataSourceAwsVpcSecurityGroupRulesConfigBasic(rName string) string {
+	return composeConfig(testAccDataSourceAwsVpcSecurityGroupRulesConfigBase(rName), `
+data "aws_vpc_security_group_rules" "test" {
+  filter {
+    name   = "group-id"
+    values = [aws_security_group.test.id]
+  }
+
+  depends_on = [
+    aws_vpc_security_group_ingress_rule.test,
+    aws_vpc_security_group_egress_rule.test,
+  ]
+}
+`)
+}
+
+func testAccDataSourceAwsVpcSecurityGroupRulesConfigTags(rName string) string {
+	return composeConfig(testAccDataSourceAwsVpcSecurityGroupRulesConfigBase(rName), `
+data "aws_vpc_security_group_rules" "test" {
+  tags = {
+    Name = aws_vpc_security_group_ingress_rule.test.tags["Name"]
+  }
+}
+`)
+}
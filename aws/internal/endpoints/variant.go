@@ -0,0 +1,154 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Variant identifies the FIPS and dual-stack (IPv4 and IPv6) endpoint variants.
+// The zero value is the default IPv4-only, non-FIPS variant.
+type Variant struct {
+	FIPS      bool
+	DualStack bool
+}
+
+// IsDefault returns whether the variant is the default endpoint variant.
+func (v Variant) IsDefault() bool {
+	return !v.FIPS && !v.DualStack
+}
+
+// String returns a description of the variant, e.g. "FIPS and dual-stack".
+func (v Variant) String() string {
+	switch {
+	case v.FIPS && v.DualStack:
+		return "FIPS and dual-stack"
+	case v.FIPS:
+		return "FIPS"
+	case v.DualStack:
+		return "dual-stack"
+	default:
+		return "default"
+	}
+}
+
+// DualStackEndpointState returns the AWS SDK dual-stack endpoint state for the variant.
+func (v Variant) DualStackEndpointState() endpoints.DualStackEndpointState {
+	if v.DualStack {
+		return endpoints.DualStackEndpointStateEnabled
+	}
+
+	return endpoints.DualStackEndpointStateUnset
+}
+
+// FIPSEndpointState returns the AWS SDK FIPS endpoint state for the variant.
+func (v Variant) FIPSEndpointState() endpoints.FIPSEndpointState {
+	if v.FIPS {
+		return endpoints.FIPSEndpointStateEnabled
+	}
+
+	return endpoints.FIPSEndpointStateUnset
+}
+
+// Options sets the AWS SDK endpoint resolver options for the variant.
+func (v Variant) Options(o *endpoints.Options) {
+	o.UseDualStackEndpoint = v.DualStackEndpointState()
+	o.UseFIPSEndpoint = v.FIPSEndpointState()
+}
+
+// S3RegionalHostname returns an S3 hostname for the variant with the region and partition DNS suffix,
+// e.g. PREFIX-fips.dualstack.us-west-2.amazonaws.com for the FIPS and dual-stack variant.
+// S3 dual-stack hostnames use the dualstack label rather than the dual-stack DNS suffix used by other services.
+func (v Variant) S3RegionalHostname(prefix, region, dnsSuffix string) string {
+	if v.FIPS {
+		prefix += "-fips"
+	}
+
+	if v.DualStack {
+		prefix += ".dualstack"
+	}
+
+	return fmt.Sprintf("%s.%s.%s", prefix, region, dnsSuffix)
+}
+
+// NewResolver returns an endpoint resolver that returns an error when the FIPS or dual-stack
+// endpoint requested by the resolver options is not modeled by the specified resolver.
+// Otherwise the AWS SDK derives an endpoint from the partition hostname template, which
+// may not exist.
+func NewResolver(resolver endpoints.Resolver) endpoints.Resolver {
+	if resolver == nil {
+		resolver = endpoints.DefaultResolver()
+	}
+
+	return endpoints.ResolverFunc(func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		resolved, err := resolver.EndpointFor(service, region, optFns...)
+
+		if err != nil {
+			return resolved, err
+		}
+
+		var opts endpoints.Options
+		opts.Set(optFns...)
+
+		variant := Variant{
+			DualStack: opts.UseDualStackEndpoint == endpoints.DualStackEndpointStateEnabled,
+			FIPS:      opts.UseFIPSEndpoint == endpoints.FIPSEndpointStateEnabled,
+		}
+
+		if variant.IsDefault() || isModeled(resolver, service, region, optFns...) {
+			return resolved, nil
+		}
+
+		return endpoints.ResolvedEndpoint{}, fmt.Errorf("%s endpoint for service (%s) is not available in region (%s)", variant, service, region)
+	})
+}
+
+// ValidateEndpointHandler returns a request handler, to be added to the front of the Validate handler list,
+// that fails requests from service clients without an endpoint for the variant with a clearer error than
+// the AWS SDK MissingEndpoint error.
+func (v Variant) ValidateEndpointHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "tfendpoints.ValidateEndpointVariant",
+		Fn: func(r *request.Request) {
+			if v.IsDefault() || r.ClientInfo.Endpoint != "" {
+				return
+			}
+
+			r.Error = fmt.Errorf("%s endpoint for service (%s) is not available in region (%s), configure an explicit endpoint in the provider endpoints configuration block", v, r.ClientInfo.ServiceID, aws.StringValue(r.Config.Region))
+		},
+	}
+}
+
+// isModeled returns whether the resolver models the endpoint variant requested by the resolver options.
+// Services without regional endpoints, such as IAM, are modeled with a global endpoint for the partition.
+func isModeled(resolver endpoints.Resolver, service, region string, optFns ...func(*endpoints.Options)) bool {
+	strictOptFns := withOption(optFns, endpoints.StrictMatchingOption)
+
+	if _, err := resolver.EndpointFor(service, region, strictOptFns...); err == nil {
+		return true
+	}
+
+	defaultOptFns := withOption(strictOptFns, Variant{}.Options)
+
+	if _, err := resolver.EndpointFor(service, region, defaultOptFns...); err == nil {
+		// The service has a regional endpoint without the variant.
+		return false
+	}
+
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+
+	if !ok {
+		return false
+	}
+
+	_, err := resolver.EndpointFor(service, partition.ID()+"-global", strictOptFns...)
+
+	return err == nil
+}
+
+// withOption returns a copy of the resolver options with the specified option appended.
+func withOption(optFns []func(*endpoints.Options), optFn func(*endpoints.Options)) []func(*endpoints.Options) {
+	return append(append([]func(*endpoints.Options){}, optFns...), optFn)
+}
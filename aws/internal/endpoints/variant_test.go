@@ -0,0 +1,206 @@
+package endpoints_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	tfendpoints "github.com/terraform-providers/terraform-provider-aws/aws/internal/endpoints"
+)
+
+func TestVariantS3RegionalHostname(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Variant   tfendpoints.Variant
+		Prefix    string
+		Region    string
+		DNSSuffix string
+		Expected  string
+	}{
+		{
+			Name:      "default",
+			Prefix:    "test.s3",
+			Region:    "us-west-2", //lintignore:AWSAT003
+			DNSSuffix: "amazonaws.com",
+			Expected:  "test.s3.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:      "FIPS",
+			Variant:   tfendpoints.Variant{FIPS: true},
+			Prefix:    "test.s3",
+			Region:    "us-west-2", //lintignore:AWSAT003
+			DNSSuffix: "amazonaws.com",
+			Expected:  "test.s3-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:      "dual-stack",
+			Variant:   tfendpoints.Variant{DualStack: true},
+			Prefix:    "test.s3",
+			Region:    "cn-northwest-1", //lintignore:AWSAT003
+			DNSSuffix: "amazonaws.com.cn",
+			Expected:  "test.s3.dualstack.cn-northwest-1.amazonaws.com.cn", //lintignore:AWSAT003
+		},
+		{
+			Name:      "FIPS and dual-stack",
+			Variant:   tfendpoints.Variant{FIPS: true, DualStack: true},
+			Prefix:    "test.s3",
+			Region:    "us-gov-west-1", //lintignore:AWSAT003
+			DNSSuffix: "amazonaws.com",
+			Expected:  "test.s3-fips.dualstack.us-gov-west-1.amazonaws.com", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.Variant.S3RegionalHostname(testCase.Prefix, testCase.Region, testCase.DNSSuffix)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestNewResolver(t *testing.T) {
+	testCases := []struct {
+		Name                  string
+		Variant               tfendpoints.Variant
+		Service               string
+		Region                string
+		ExpectedURL           string
+		ExpectedSigningRegion string
+		ExpectError           bool
+	}{
+		{
+			Name:                  "default",
+			Service:               "iotanalytics",
+			Region:                "us-west-2",                                    //lintignore:AWSAT003
+			ExpectedURL:           "https://iotanalytics.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			ExpectedSigningRegion: "us-west-2",                                    //lintignore:AWSAT003
+		},
+		{
+			Name:                  "FIPS",
+			Variant:               tfendpoints.Variant{FIPS: true},
+			Service:               "sqs",
+			Region:                "us-west-2",                                //lintignore:AWSAT003
+			ExpectedURL:           "https://sqs-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			ExpectedSigningRegion: "us-west-2",                                //lintignore:AWSAT003
+		},
+		{
+			Name:                  "FIPS global",
+			Variant:               tfendpoints.Variant{FIPS: true},
+			Service:               "iam",
+			Region:                "us-west-2", //lintignore:AWSAT003
+			ExpectedURL:           "https://iam-fips.amazonaws.com",
+			ExpectedSigningRegion: "us-east-1", //lintignore:AWSAT003
+		},
+		{
+			Name:        "FIPS not available",
+			Variant:     tfendpoints.Variant{FIPS: true},
+			Service:     "iotanalytics",
+			Region:      "us-west-2", //lintignore:AWSAT003
+			ExpectError: true,
+		},
+		{
+			Name:        "FIPS global not available",
+			Variant:     tfendpoints.Variant{FIPS: true},
+			Service:     "cloudfront",
+			Region:      "us-west-2", //lintignore:AWSAT003
+			ExpectError: true,
+		},
+		{
+			Name:                  "dual-stack",
+			Variant:               tfendpoints.Variant{DualStack: true},
+			Service:               "s3",
+			Region:                "us-west-2",                                    //lintignore:AWSAT003
+			ExpectedURL:           "https://s3.dualstack.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			ExpectedSigningRegion: "us-west-2",                                    //lintignore:AWSAT003
+		},
+		{
+			Name:        "dual-stack not available",
+			Variant:     tfendpoints.Variant{DualStack: true},
+			Service:     "sts",
+			Region:      "us-west-2", //lintignore:AWSAT003
+			ExpectError: true,
+		},
+		{
+			Name:                  "FIPS and dual-stack",
+			Variant:               tfendpoints.Variant{FIPS: true, DualStack: true},
+			Service:               "s3",
+			Region:                "us-east-1",                                         //lintignore:AWSAT003
+			ExpectedURL:           "https://s3-fips.dualstack.us-east-1.amazonaws.com", //lintignore:AWSAT003
+			ExpectedSigningRegion: "us-east-1",                                         //lintignore:AWSAT003
+		},
+		{
+			Name:        "unknown service",
+			Variant:     tfendpoints.Variant{FIPS: true},
+			Service:     "unknown",
+			Region:      "us-west-2", //lintignore:AWSAT003
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			resolver := tfendpoints.NewResolver(endpoints.DefaultResolver())
+
+			got, err := resolver.EndpointFor(testCase.Service, testCase.Region, testCase.Variant.Options, endpoints.ResolveUnknownServiceOption)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got.URL)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.URL != testCase.ExpectedURL {
+				t.Errorf("got URL %s, expected %s", got.URL, testCase.ExpectedURL)
+			}
+
+			if got.SigningRegion != testCase.ExpectedSigningRegion {
+				t.Errorf("got signing region %s, expected %s", got.SigningRegion, testCase.ExpectedSigningRegion)
+			}
+		})
+	}
+}
+
+func TestVariantValidateEndpointHandler(t *testing.T) {
+	t.Setenv("AWS_CA_BUNDLE", "")
+
+	variant := tfendpoints.Variant{FIPS: true}
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:     credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:          aws.String("us-west-2"), //lintignore:AWSAT003
+		UseFIPSEndpoint: variant.FIPSEndpointState(),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	sess = sess.Copy(&aws.Config{EndpointResolver: tfendpoints.NewResolver(sess.Config.EndpointResolver)})
+	sess.Handlers.Validate.PushFrontNamed(variant.ValidateEndpointHandler())
+
+	_, err = cloudfront.New(sess).ListDistributions(&cloudfront.ListDistributionsInput{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, expected := err.Error(), "FIPS endpoint for service (CloudFront) is not available in region (us-west-2)"; !strings.Contains(got, expected) { //lintignore:AWSAT003
+		t.Errorf("got error %q, expected it to contain %q", got, expected)
+	}
+}
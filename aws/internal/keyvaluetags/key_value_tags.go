@@ -8,6 +8,7 @@ package keyvaluetags
 
 import (
 	"fmt"
+	"log"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -25,9 +26,26 @@ const (
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+const (
+	RequiredEnforcementError = `error`
+	RequiredEnforcementWarn  = `warn`
+)
+
+// RequiredEnforcements returns the valid required tags enforcement levels.
+func RequiredEnforcements() []string {
+	return []string{
+		RequiredEnforcementError,
+		RequiredEnforcementWarn,
+	}
+}
+
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// PropagateToLaunchedInstances enables propagating Tags to the instances
+	// and volumes launched by Auto Scaling Groups and Launch Templates.
+	PropagateToLaunchedInstances bool
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -36,6 +54,20 @@ type IgnoreConfig struct {
 	KeyPrefixes KeyValueTags
 }
 
+// RequiredConfig contains tag keys and tag value patterns required across all resources.
+type RequiredConfig struct {
+	// Keys are the tag keys that must be present.
+	Keys KeyValueTags
+
+	// KeyPatterns are the regular expressions the values of the tags
+	// with matching keys must match, when present.
+	KeyPatterns map[string]*regexp.Regexp
+
+	// Enforcement is the level at which violations are reported,
+	// either RequiredEnforcementError or RequiredEnforcementWarn.
+	Enforcement string
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
@@ -64,6 +96,16 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// LaunchedInstanceTags returns the DefaultConfig's Tags to propagate to
+// launched instances and volumes, if any
+func (dc *DefaultConfig) LaunchedInstanceTags() KeyValueTags {
+	if dc == nil || !dc.PropagateToLaunchedInstances {
+		return nil
+	}
+
+	return dc.Tags
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -94,6 +136,105 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// Missing returns the required tag keys not present in the
+// KeyValueTags provided as an argument.
+func (rc *RequiredConfig) Missing(tags KeyValueTags) KeyValueTags {
+	if rc == nil || New(tags.Keys()).ContainsAll(rc.Keys) {
+		return make(KeyValueTags)
+	}
+
+	return rc.Keys.Removed(tags)
+}
+
+// Mismatched returns the tags in the KeyValueTags provided as an argument
+// whose values do not match the required pattern for their key.
+func (rc *RequiredConfig) Mismatched(tags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	if rc == nil {
+		return result
+	}
+
+	for k, v := range tags {
+		pattern, ok := rc.KeyPatterns[k]
+
+		if !ok || pattern == nil {
+			continue
+		}
+
+		var value string
+
+		if v != nil && v.Value != nil {
+			value = *v.Value
+		}
+
+		if !pattern.MatchString(value) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Validate returns an error describing any required tag keys missing from,
+// or tag values not matching the required patterns in, the KeyValueTags
+// provided as an argument; otherwise returns nil.
+func (rc *RequiredConfig) Validate(tags KeyValueTags) error {
+	var violations []string
+
+	if missing := rc.Missing(tags); len(missing) > 0 {
+		keys := missing.Keys()
+		sort.Strings(keys)
+
+		violations = append(violations, fmt.Sprintf("missing required tag keys: %s", strings.Join(keys, ", ")))
+	}
+
+	mismatched := rc.Mismatched(tags)
+	keys := mismatched.Keys()
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		var value string
+
+		if v := mismatched.KeyValue(k); v != nil {
+			value = *v
+		}
+
+		violations = append(violations, fmt.Sprintf("tag %q value %q does not match required pattern %q", k, value, rc.KeyPatterns[k].String()))
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("tags do not satisfy the \"required_tags\" configuration block of the provider: %s", strings.Join(violations, "; "))
+}
+
+// Enforce validates the KeyValueTags provided as an argument and returns
+// any violation as an error, or logs it as a warning and returns nil
+// when the enforcement level is RequiredEnforcementWarn.
+func (rc *RequiredConfig) Enforce(tags KeyValueTags) error {
+	err := rc.Validate(tags)
+
+	if err != nil && rc.Enforcement == RequiredEnforcementWarn {
+		log.Printf("[WARN] %s", err)
+
+		return nil
+	}
+
+	return err
+}
+
+// Ignored returns the required tag keys removed by a given ignore
+// configuration, which can never be satisfied.
+func (rc *RequiredConfig) Ignored(config *IgnoreConfig) KeyValueTags {
+	if rc == nil {
+		return make(KeyValueTags)
+	}
+
+	return rc.Keys.Removed(rc.Keys.IgnoreConfig(config))
+}
+
 // IgnoreConfig returns any tags not removed by a given configuration.
 func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	if config == nil {
@@ -1,6 +1,9 @@
 package keyvaluetags
 
 import (
+	"reflect"
+	"regexp"
+	"sort"
 	"testing"
 )
 
@@ -43,6 +46,50 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigLaunchedInstanceTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			want:          map[string]string{},
+		},
+		{
+			name: "propagation disabled",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "propagation enabled",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+				PropagateToLaunchedInstances: true,
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.LaunchedInstanceTags()
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -291,6 +338,339 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRequiredConfigMissing(t *testing.T) {
+	testCases := []struct {
+		name           string
+		tags           KeyValueTags
+		requiredConfig *RequiredConfig
+		want           map[string]string
+	}{
+		{
+			name: "nil config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: nil,
+			want:           map[string]string{},
+		},
+		{
+			name: "all present",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{"key1", "key2"}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "some missing",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{"key1", "key2", "key3"}),
+			},
+			want: map[string]string{
+				"key2": "",
+				"key3": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.requiredConfig.Missing(testCase.tags)
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsRequiredConfigMismatched(t *testing.T) {
+	testCases := []struct {
+		name           string
+		tags           KeyValueTags
+		requiredConfig *RequiredConfig
+		want           map[string]string
+	}{
+		{
+			name: "nil config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: nil,
+			want:           map[string]string{},
+		},
+		{
+			name: "all match",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			requiredConfig: &RequiredConfig{
+				KeyPatterns: map[string]*regexp.Regexp{
+					"key1": regexp.MustCompile(`^value\d$`),
+				},
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "some mismatched",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "other",
+				"key3": "value3",
+			}),
+			requiredConfig: &RequiredConfig{
+				KeyPatterns: map[string]*regexp.Regexp{
+					"key1": regexp.MustCompile(`^value\d$`),
+					"key2": regexp.MustCompile(`^value\d$`),
+					"key4": regexp.MustCompile(`^value\d$`),
+				},
+			},
+			want: map[string]string{
+				"key2": "other",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.requiredConfig.Mismatched(testCase.tags)
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsRequiredConfigValidate(t *testing.T) {
+	requiredConfig := &RequiredConfig{
+		Keys: New([]string{"key1", "key2"}),
+		KeyPatterns: map[string]*regexp.Regexp{
+			"key2": regexp.MustCompile(`^(dev|prod)$`),
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		tags           KeyValueTags
+		defaultConfig  *DefaultConfig
+		requiredConfig *RequiredConfig
+		wantErr        string
+	}{
+		{
+			name: "nil config",
+			tags: New(map[string]string{
+				"key3": "value3",
+			}),
+			requiredConfig: nil,
+		},
+		{
+			name: "resource tags satisfy config",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "dev",
+			}),
+			requiredConfig: requiredConfig,
+		},
+		{
+			name: "default tags satisfy config",
+			tags: New(map[string]string{
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "prod",
+				}),
+			},
+			requiredConfig: requiredConfig,
+		},
+		{
+			name: "default and resource tags satisfy config",
+			tags: New(map[string]string{
+				"key2": "dev",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			requiredConfig: requiredConfig,
+		},
+		{
+			name: "resource tags override default tags with mismatched value",
+			tags: New(map[string]string{
+				"key2": "test",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "prod",
+				}),
+			},
+			requiredConfig: requiredConfig,
+			wantErr:        `tags do not satisfy the "required_tags" configuration block of the provider: tag "key2" value "test" does not match required pattern "^(dev|prod)$"`,
+		},
+		{
+			name: "missing keys and mismatched value",
+			tags: New(map[string]string{
+				"key2": "test",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key3": "value3",
+				}),
+			},
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{"key1", "key2", "key4"}),
+				KeyPatterns: map[string]*regexp.Regexp{
+					"key2": regexp.MustCompile(`^(dev|prod)$`),
+				},
+			},
+			wantErr: `tags do not satisfy the "required_tags" configuration block of the provider: missing required tag keys: key1, key4; tag "key2" value "test" does not match required pattern "^(dev|prod)$"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.requiredConfig.Validate(testCase.defaultConfig.MergeTags(testCase.tags))
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.wantErr)
+			}
+
+			if err.Error() != testCase.wantErr {
+				t.Errorf("got error %q, expected %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsRequiredConfigEnforce(t *testing.T) {
+	tags := New(map[string]string{
+		"key2": "value2",
+	})
+
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		wantErr        bool
+	}{
+		{
+			name:           "nil config",
+			requiredConfig: nil,
+		},
+		{
+			name: "default enforcement",
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{"key1"}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "error enforcement",
+			requiredConfig: &RequiredConfig{
+				Keys:        New([]string{"key1"}),
+				Enforcement: RequiredEnforcementError,
+			},
+			wantErr: true,
+		},
+		{
+			name: "warn enforcement",
+			requiredConfig: &RequiredConfig{
+				Keys:        New([]string{"key1"}),
+				Enforcement: RequiredEnforcementWarn,
+			},
+		},
+		{
+			name: "error enforcement satisfied",
+			requiredConfig: &RequiredConfig{
+				Keys:        New([]string{"key2"}),
+				Enforcement: RequiredEnforcementError,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.requiredConfig.Enforce(tags)
+
+			if testCase.wantErr && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.wantErr && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsRequiredConfigIgnored(t *testing.T) {
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		ignoreConfig   *IgnoreConfig
+		want           []string
+	}{
+		{
+			name:           "nil config",
+			requiredConfig: nil,
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{"key1"}),
+			},
+			want: []string{},
+		},
+		{
+			name: "nil ignore config",
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{"key1"}),
+			},
+			want: []string{},
+		},
+		{
+			name: "keys",
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{"key1", "key2", "key3"}),
+			},
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{"key1", "key4"}),
+			},
+			want: []string{"key1"},
+		},
+		{
+			name: "key prefixes",
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{"key1", "team:owner", "team:cost"}),
+			},
+			ignoreConfig: &IgnoreConfig{
+				KeyPrefixes: New([]string{"team:"}),
+			},
+			want: []string{"team:cost", "team:owner"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.requiredConfig.Ignored(testCase.ignoreConfig).Keys()
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, expected %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAws(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -19,11 +19,15 @@ import (
 
 // S3BucketListTags lists S3 bucket tags.
 // The identifier is the bucket name.
-func S3BucketListTags(conn *s3.S3, identifier string) (KeyValueTags, error) {
+func S3BucketListTags(conn *s3.S3, identifier, expectedBucketOwner string) (KeyValueTags, error) {
 	input := &s3.GetBucketTaggingInput{
 		Bucket: aws.String(identifier),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	output, err := conn.GetBucketTagging(input)
 
 	// S3 API Reference (https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketTagging.html)
@@ -42,12 +46,12 @@ func S3BucketListTags(conn *s3.S3, identifier string) (KeyValueTags, error) {
 
 // S3BucketUpdateTags updates S3 bucket tags.
 // The identifier is the bucket name.
-func S3BucketUpdateTags(conn *s3.S3, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+func S3BucketUpdateTags(conn *s3.S3, identifier, expectedBucketOwner string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
 	// We need to also consider any existing ignored tags.
-	allTags, err := S3BucketListTags(conn, identifier)
+	allTags, err := S3BucketListTags(conn, identifier, expectedBucketOwner)
 
 	if err != nil {
 		return fmt.Errorf("error listing resource tags (%s): %w", identifier, err)
@@ -63,6 +67,10 @@ func S3BucketUpdateTags(conn *s3.S3, identifier string, oldTagsMap interface{},
 			},
 		}
 
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
 		_, err := conn.PutBucketTagging(input)
 
 		if err != nil {
@@ -73,6 +81,10 @@ func S3BucketUpdateTags(conn *s3.S3, identifier string, oldTagsMap interface{},
 			Bucket: aws.String(identifier),
 		}
 
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
 		_, err := conn.DeleteBucketTagging(input)
 
 		if err != nil {
@@ -84,12 +96,16 @@ func S3BucketUpdateTags(conn *s3.S3, identifier string, oldTagsMap interface{},
 }
 
 // S3ObjectListTags lists S3 object tags.
-func S3ObjectListTags(conn *s3.S3, bucket, key string) (KeyValueTags, error) {
+func S3ObjectListTags(conn *s3.S3, bucket, key, expectedBucketOwner string) (KeyValueTags, error) {
 	input := &s3.GetObjectTaggingInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	var output *s3.GetObjectTaggingOutput
 
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
@@ -124,12 +140,12 @@ func S3ObjectListTags(conn *s3.S3, bucket, key string) (KeyValueTags, error) {
 }
 
 // S3ObjectUpdateTags updates S3 object tags.
-func S3ObjectUpdateTags(conn *s3.S3, bucket, key string, oldTagsMap interface{}, newTagsMap interface{}) error {
+func S3ObjectUpdateTags(conn *s3.S3, bucket, key, expectedBucketOwner string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
 	// We need to also consider any existing ignored tags.
-	allTags, err := S3ObjectListTags(conn, bucket, key)
+	allTags, err := S3ObjectListTags(conn, bucket, key, expectedBucketOwner)
 
 	if err != nil {
 		return fmt.Errorf("error listing resource tags (%s/%s): %w", bucket, key, err)
@@ -146,6 +162,10 @@ func S3ObjectUpdateTags(conn *s3.S3, bucket, key string, oldTagsMap interface{},
 			},
 		}
 
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
 		_, err := conn.PutObjectTagging(input)
 
 		if err != nil {
@@ -157,6 +177,10 @@ func S3ObjectUpdateTags(conn *s3.S3, bucket, key string, oldTagsMap interface{},
 			Key:    aws.String(key),
 		}
 
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
 		_, err := conn.DeleteObjectTagging(input)
 
 		if err != nil {
@@ -0,0 +1,212 @@
+package logging
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// DefaultMaxBodySize is the largest request or response body, in bytes, that is logged.
+const DefaultMaxBodySize = 64 * 1024
+
+// Logger logs AWS API requests and responses with the values of sensitive fields redacted.
+// It replaces the AWS SDK debug logging, which logs bodies verbatim.
+type Logger struct {
+	// MaxBodySize is the largest body, in bytes, that is logged.
+	// Larger bodies are omitted.
+	MaxBodySize int
+
+	// Redactions are the sensitive fields redacted from the logged bodies.
+	Redactions Redactions
+
+	logf func(format string, v ...interface{})
+	now  func() time.Time
+}
+
+// NewLogger returns a new Logger, writing to the standard logger, that redacts the default sensitive fields.
+func NewLogger() *Logger {
+	return &Logger{
+		MaxBodySize: DefaultMaxBodySize,
+		Redactions:  DefaultRedactions(),
+		logf:        log.Printf,
+		now:         time.Now,
+	}
+}
+
+// SendHandler returns a request handler, to be added to the back of the Send handler list,
+// that captures the response body as it is read by the unmarshalers.
+func (l *Logger) SendHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "tflogging.CaptureResponseBody",
+		Fn: func(r *request.Request) {
+			if r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+				return
+			}
+
+			r.HTTPResponse.Body = &capturingReadCloser{
+				ReadCloser: r.HTTPResponse.Body,
+				buffer:     &limitedBuffer{limit: l.MaxBodySize},
+			}
+		},
+	}
+}
+
+// CompleteHandler returns a request handler, to be added to the Complete handler list,
+// that logs the operation name, latency, retry count and status of the request and
+// its redacted request and response bodies.
+func (l *Logger) CompleteHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "tflogging.LogRequest",
+		Fn: func(r *request.Request) {
+			l.logf("[DEBUG] [aws-sdk-go] %s", l.Message(r))
+		},
+	}
+}
+
+// Message returns the log message for a completed request.
+func (l *Logger) Message(r *request.Request) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s/%s: ", r.ClientInfo.ServiceName, r.Operation.Name)
+
+	if r.HTTPResponse != nil {
+		fmt.Fprintf(&b, "status %d", r.HTTPResponse.StatusCode)
+	} else {
+		b.WriteString("no response")
+	}
+
+	if err, ok := r.Error.(awserr.Error); ok {
+		fmt.Fprintf(&b, " (%s)", err.Code())
+	}
+
+	fmt.Fprintf(&b, ", latency %s, retries %d", l.now().Sub(r.Time).Round(time.Millisecond), r.RetryCount)
+
+	if v := l.requestBody(r); v != "" {
+		fmt.Fprintf(&b, "\nRequest body: %s", v)
+	}
+
+	if v := l.responseBody(r); v != "" {
+		fmt.Fprintf(&b, "\nResponse body: %s", v)
+	}
+
+	return b.String()
+}
+
+func (l *Logger) requestBody(r *request.Request) string {
+	if r.HTTPRequest == nil || hasBlobPayload(r.Params) || !aws.IsReaderSeekable(r.Body) {
+		return ""
+	}
+
+	if _, err := r.Body.Seek(r.BodyStart, io.SeekStart); err != nil {
+		return ""
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, int64(l.MaxBodySize)+1))
+
+	if err != nil {
+		return ""
+	}
+
+	if len(body) > l.MaxBodySize {
+		return fmt.Sprintf("[more than %d bytes omitted]", l.MaxBodySize)
+	}
+
+	return l.Redactions.Redact(r.ClientInfo.ServiceName, r.HTTPRequest.Header.Get("Content-Type"), body)
+}
+
+func (l *Logger) responseBody(r *request.Request) string {
+	if r.HTTPResponse == nil || (r.Error == nil && hasBlobPayload(r.Data)) {
+		return ""
+	}
+
+	body, ok := r.HTTPResponse.Body.(*capturingReadCloser)
+
+	if !ok {
+		return ""
+	}
+
+	if body.buffer.overflowed {
+		return fmt.Sprintf("[more than %d bytes omitted]", l.MaxBodySize)
+	}
+
+	return l.Redactions.Redact(r.ClientInfo.ServiceName, r.HTTPResponse.Header.Get("Content-Type"), body.buffer.Bytes())
+}
+
+// hasBlobPayload returns whether the input or output shape is sent as the
+// unstructured HTTP body, e.g. S3 object contents and Lambda invocation payloads,
+// which are never logged.
+func hasBlobPayload(v interface{}) bool {
+	t := reflect.TypeOf(v)
+
+	if t == nil {
+		return false
+	}
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+
+	metadata, ok := t.FieldByName("_")
+
+	if !ok {
+		return false
+	}
+
+	payload, ok := t.FieldByName(metadata.Tag.Get("payload"))
+
+	if !ok {
+		return false
+	}
+
+	return payload.Tag.Get("type") == "blob"
+}
+
+// capturingReadCloser copies the data read from a response body.
+type capturingReadCloser struct {
+	io.ReadCloser
+
+	buffer *limitedBuffer
+}
+
+func (c *capturingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.buffer.Write(p[:n])
+
+	return n, err
+}
+
+// limitedBuffer is a bytes.Buffer that discards its contents once they exceed a limit.
+type limitedBuffer struct {
+	bytes.Buffer
+
+	limit      int
+	overflowed bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.overflowed {
+		return len(p), nil
+	}
+
+	if b.Len()+len(p) > b.limit {
+		b.overflowed = true
+		b.Reset()
+
+		return len(p), nil
+	}
+
+	return b.Buffer.Write(p)
+}
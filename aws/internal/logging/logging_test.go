@@ -0,0 +1,177 @@
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+)
+
+func TestLoggerMessage(t *testing.T) {
+	start := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name         string
+		serviceName  string
+		operation    string
+		params       interface{}
+		data         interface{}
+		requestBody  string
+		responseBody string
+		statusCode   int
+		err          error
+		retryCount   int
+		maxBodySize  int
+		want         string
+	}{
+		{
+			name:         "success",
+			serviceName:  "secretsmanager",
+			operation:    "GetSecretValue",
+			params:       &secretsmanager.GetSecretValueInput{},
+			data:         &secretsmanager.GetSecretValueOutput{},
+			requestBody:  `{"SecretId":"test"}`,
+			responseBody: `{"Name":"test","SecretString":"hunter2"}`,
+			statusCode:   http.StatusOK,
+			retryCount:   2,
+			want: `secretsmanager/GetSecretValue: status 200, latency 1.5s, retries 2
+Request body: {"SecretId":"test"}
+Response body: {"Name":"test","SecretString":"[REDACTED]"}`,
+		},
+		{
+			name:         "error",
+			serviceName:  "secretsmanager",
+			operation:    "GetSecretValue",
+			params:       &secretsmanager.GetSecretValueInput{},
+			data:         &secretsmanager.GetSecretValueOutput{},
+			requestBody:  `{"SecretId":"test"}`,
+			responseBody: `{"__type":"ResourceNotFoundException"}`,
+			statusCode:   http.StatusBadRequest,
+			err:          awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "not found", nil),
+			want: `secretsmanager/GetSecretValue: status 400 (ResourceNotFoundException), latency 1.5s, retries 0
+Request body: {"SecretId":"test"}
+Response body: {"__type":"ResourceNotFoundException"}`,
+		},
+		{
+			name:        "no response",
+			serviceName: "secretsmanager",
+			operation:   "GetSecretValue",
+			params:      &secretsmanager.GetSecretValueInput{},
+			data:        &secretsmanager.GetSecretValueOutput{},
+			requestBody: `{"SecretId":"test"}`,
+			err:         awserr.New(request.ErrCodeRequestError, "send request failed", errors.New("connection refused")),
+			retryCount:  3,
+			want: `secretsmanager/GetSecretValue: no response (RequestError), latency 1.5s, retries 3
+Request body: {"SecretId":"test"}`,
+		},
+		{
+			name:         "blob payloads",
+			serviceName:  "lambda",
+			operation:    "Invoke",
+			params:       &lambda.InvokeInput{},
+			data:         &lambda.InvokeOutput{},
+			requestBody:  `{"password":"hunter2"}`,
+			responseBody: `{"password":"hunter2"}`,
+			statusCode:   http.StatusOK,
+			want:         `lambda/Invoke: status 200, latency 1.5s, retries 0`,
+		},
+		{
+			name:         "large bodies",
+			serviceName:  "secretsmanager",
+			operation:    "GetSecretValue",
+			params:       &secretsmanager.GetSecretValueInput{},
+			data:         &secretsmanager.GetSecretValueOutput{},
+			requestBody:  `{"SecretId":"test"}`,
+			responseBody: `{"Name":"test","SecretString":"hunter2"}`,
+			statusCode:   http.StatusOK,
+			maxBodySize:  10,
+			want: `secretsmanager/GetSecretValue: status 200, latency 1.5s, retries 0
+Request body: [more than 10 bytes omitted]
+Response body: [more than 10 bytes omitted]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			logger := NewLogger()
+			logger.now = func() time.Time { return start.Add(1500 * time.Millisecond) }
+
+			if testCase.maxBodySize > 0 {
+				logger.MaxBodySize = testCase.maxBodySize
+			}
+
+			httpRequest, _ := http.NewRequest(http.MethodPost, "https://example.com/", nil)
+			httpRequest.Header.Set("Content-Type", "application/x-amz-json-1.1")
+
+			r := &request.Request{
+				ClientInfo:  metadata.ClientInfo{ServiceName: testCase.serviceName},
+				Operation:   &request.Operation{Name: testCase.operation},
+				HTTPRequest: httpRequest,
+				Body:        strings.NewReader(testCase.requestBody),
+				Params:      testCase.params,
+				Data:        testCase.data,
+				Error:       testCase.err,
+				RetryCount:  testCase.retryCount,
+				Time:        start,
+			}
+
+			// Simulate the request body having been sent.
+			if _, err := r.Body.Seek(0, io.SeekEnd); err != nil {
+				t.Fatalf("error seeking request body: %s", err)
+			}
+
+			if testCase.statusCode != 0 {
+				r.HTTPResponse = &http.Response{
+					StatusCode: testCase.statusCode,
+					Header:     http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+					Body:       ioutil.NopCloser(bytes.NewBufferString(testCase.responseBody)),
+				}
+			}
+
+			logger.SendHandler().Fn(r)
+
+			// Simulate the response body being read by the unmarshalers.
+			if r.HTTPResponse != nil {
+				if _, err := ioutil.ReadAll(r.HTTPResponse.Body); err != nil {
+					t.Fatalf("error reading response body: %s", err)
+				}
+			}
+
+			if got := logger.Message(r); got != testCase.want {
+				t.Errorf("got %s, expected %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestLoggerCompleteHandler(t *testing.T) {
+	var got string
+
+	logger := NewLogger()
+	logger.logf = func(format string, v ...interface{}) {
+		got = format
+	}
+
+	httpRequest, _ := http.NewRequest(http.MethodPost, "https://example.com/", nil)
+
+	logger.CompleteHandler().Fn(&request.Request{
+		ClientInfo:  metadata.ClientInfo{ServiceName: "sts"},
+		Operation:   &request.Operation{Name: "GetCallerIdentity"},
+		HTTPRequest: httpRequest,
+		Time:        time.Now(),
+	})
+
+	if want := "[DEBUG] [aws-sdk-go] %s"; got != want {
+		t.Errorf("got %s, expected %s", got, want)
+	}
+}
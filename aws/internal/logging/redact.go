@@ -0,0 +1,183 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// RedactedValue replaces the values of sensitive fields in logged bodies.
+const RedactedValue = "[REDACTED]"
+
+// AllServices is the Redactions key whose fields are redacted for every service.
+const AllServices = "*"
+
+// Redactions maps AWS SDK client service names, e.g. "secretsmanager", to the
+// JSON object keys, XML element names and query parameter names whose values
+// are redacted from the request and response bodies of that service. Names are
+// matched case-insensitively, e.g. "PasswordData" matches EC2's "passwordData".
+//
+// Query parameter names also match the last component of flattened
+// names, e.g. "Password" matches "Credentials.member.1.Password".
+type Redactions map[string][]string
+
+// DefaultRedactions returns the maintained list of sensitive fields for each service.
+func DefaultRedactions() Redactions {
+	return Redactions{
+		AllServices: {
+			"AuthToken",
+			"ClientSecret",
+			"MasterUserPassword",
+			"Password",
+			"PasswordData",
+			"PrivateKey",
+			"SecretAccessKey",
+			"ServicePassword",
+			"SessionToken",
+			"Token",
+		},
+		"kms": {
+			"Plaintext",
+		},
+		"secretsmanager": {
+			"RandomPassword",
+			"SecretBinary",
+			"SecretString",
+		},
+		"ssm": {
+			"Value",
+		},
+		"sts": {
+			"SAMLAssertion",
+			"WebIdentityToken",
+		},
+	}
+}
+
+// Fields returns the sorted fields redacted for the service.
+func (r Redactions) Fields(serviceName string) []string {
+	var fields []string
+
+	fields = append(fields, r[AllServices]...)
+	fields = append(fields, r[serviceName]...)
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+// Redact returns the body, in the format indicated by its content type, with the
+// values of the service's sensitive fields replaced by RedactedValue.
+// Bodies in formats other than JSON, XML and URL-encoded forms are omitted.
+func (r Redactions) Redact(serviceName, contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	fields := r.Fields(serviceName)
+
+	switch contentType = strings.ToLower(contentType); {
+	case strings.Contains(contentType, "json"):
+		return redactJSON(fields, string(body))
+	case strings.Contains(contentType, "xml"):
+		return redactXML(fields, string(body))
+	case strings.Contains(contentType, "x-www-form-urlencoded"):
+		return redactForm(fields, string(body))
+	default:
+		return fmt.Sprintf("[%d bytes of %q omitted]", len(body), contentType)
+	}
+}
+
+// redactJSON replaces the values of the matching object keys at any depth.
+// Object and array values are replaced in their entirety. The body is
+// re-encoded compactly with sorted object keys; unparseable bodies are
+// omitted rather than risk logging their values.
+func redactJSON(fields []string, body string) string {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var v interface{}
+
+	if err := decoder.Decode(&v); err != nil || decoder.More() {
+		return fmt.Sprintf("[%d bytes of unparseable JSON omitted]", len(body))
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(redactJSONValue(fields, v)); err != nil {
+		return fmt.Sprintf("[%d bytes of unencodable JSON omitted]", len(body))
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// redactJSONValue walks the decoded JSON value, replacing the values of the matching object keys.
+func redactJSONValue(fields []string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if redactedField(fields, key) {
+				v[key] = RedactedValue
+			} else {
+				v[key] = redactJSONValue(fields, value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSONValue(fields, value)
+		}
+	}
+
+	return v
+}
+
+// redactXML replaces the contents of the matching elements at any depth.
+func redactXML(fields []string, body string) string {
+	for _, field := range fields {
+		re := regexp.MustCompile(`(?is)(<` + regexp.QuoteMeta(field) + `(?:\s[^>]*)?>).*?(</` + regexp.QuoteMeta(field) + `>)`)
+		body = re.ReplaceAllString(body, `${1}`+RedactedValue+`${2}`)
+	}
+
+	return body
+}
+
+// redactForm replaces the values of the matching query parameters.
+// Unparseable forms are omitted rather than risk logging their values.
+func redactForm(fields []string, body string) string {
+	values, err := url.ParseQuery(body)
+
+	if err != nil {
+		return fmt.Sprintf("[%d bytes of unparseable form omitted]", len(body))
+	}
+
+	for name := range values {
+		key := name
+
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			key = name[i+1:]
+		}
+
+		if redactedField(fields, key) {
+			values.Set(name, RedactedValue)
+		}
+	}
+
+	return values.Encode()
+}
+
+// redactedField returns whether the name case-insensitively matches one of the fields.
+func redactedField(fields []string, name string) bool {
+	for _, field := range fields {
+		if strings.EqualFold(field, name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,208 @@
+package logging
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRedactionsRedact(t *testing.T) {
+	testCases := []struct {
+		name        string
+		serviceName string
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			name:        "empty body",
+			serviceName: "secretsmanager",
+			contentType: "application/x-amz-json-1.1",
+			want:        "",
+		},
+		{
+			name:        "secretsmanager GetSecretValue response",
+			serviceName: "secretsmanager",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"ARN":"arn:aws:secretsmanager:us-west-2:123456789012:secret:test","Name":"test","SecretString":"{\"password\":\"hunter2\"}","VersionId":"v1"}`,
+			want:        `{"ARN":"arn:aws:secretsmanager:us-west-2:123456789012:secret:test","Name":"test","SecretString":"[REDACTED]","VersionId":"v1"}`,
+		},
+		{
+			name:        "secretsmanager PutSecretValue request",
+			serviceName: "secretsmanager",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"SecretBinary": "aHVudGVyMg==", "SecretId": "test"}`,
+			want:        `{"SecretBinary":"[REDACTED]","SecretId":"test"}`,
+		},
+		{
+			name:        "ssm GetParameter response",
+			serviceName: "ssm",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"Parameter":{"Name":"/test","Type":"SecureString","Value":"hunter2","Version":1}}`,
+			want:        `{"Parameter":{"Name":"/test","Type":"SecureString","Value":"[REDACTED]","Version":1}}`,
+		},
+		{
+			name:        "ssm field not redacted for other services",
+			serviceName: "ec2",
+			contentType: "text/xml",
+			body:        `<tagSet><item><key>Name</key><value>test</value></item></tagSet><Value>test</Value>`,
+			want:        `<tagSet><item><key>Name</key><value>test</value></item></tagSet><Value>test</Value>`,
+		},
+		{
+			name:        "iam CreateAccessKey response",
+			serviceName: "iam",
+			contentType: "text/xml",
+			body:        `<CreateAccessKeyResult><AccessKey><UserName>test</UserName><AccessKeyId>AKIAEXAMPLE</AccessKeyId><Status>Active</Status><SecretAccessKey>wJalrXUtnFEMI/K7MDENG</SecretAccessKey></AccessKey></CreateAccessKeyResult>`,
+			want:        `<CreateAccessKeyResult><AccessKey><UserName>test</UserName><AccessKeyId>AKIAEXAMPLE</AccessKeyId><Status>Active</Status><SecretAccessKey>[REDACTED]</SecretAccessKey></AccessKey></CreateAccessKeyResult>`,
+		},
+		{
+			name:        "iam CreateLoginProfile request",
+			serviceName: "iam",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        `Action=CreateLoginProfile&Password=hunter2&PasswordResetRequired=true&UserName=test&Version=2010-05-08`,
+			want:        `Action=CreateLoginProfile&Password=%5BREDACTED%5D&PasswordResetRequired=true&UserName=test&Version=2010-05-08`,
+		},
+		{
+			name:        "sts AssumeRoleWithWebIdentity request",
+			serviceName: "sts",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        `Action=AssumeRoleWithWebIdentity&RoleArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Arole%2Ftest&WebIdentityToken=eyJhbGciOi`,
+			want:        `Action=AssumeRoleWithWebIdentity&RoleArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Arole%2Ftest&WebIdentityToken=%5BREDACTED%5D`,
+		},
+		{
+			name:        "flattened query parameter",
+			serviceName: "rds",
+			contentType: "application/x-www-form-urlencoded",
+			body:        `Action=ModifyDBCluster&Options.member.1.Password=hunter2`,
+			want:        `Action=ModifyDBCluster&Options.member.1.Password=%5BREDACTED%5D`,
+		},
+		{
+			name:        "unparseable form",
+			serviceName: "iam",
+			contentType: "application/x-www-form-urlencoded",
+			body:        `Password=%zz`,
+			want:        `[12 bytes of unparseable form omitted]`,
+		},
+		{
+			name:        "JSON non-string value",
+			serviceName: "elasticache",
+			contentType: "application/json",
+			body:        `{"AuthToken":12345,"Other":true}`,
+			want:        `{"AuthToken":"[REDACTED]","Other":true}`,
+		},
+		{
+			name:        "JSON escaped quotes",
+			serviceName: "rds",
+			contentType: "application/json",
+			body:        `{"MasterUserPassword":"a\"b\\","Engine":"aurora"}`,
+			want:        `{"Engine":"aurora","MasterUserPassword":"[REDACTED]"}`,
+		},
+		{
+			name:        "JSON key prefix not redacted",
+			serviceName: "rds",
+			contentType: "application/json",
+			body:        `{"PasswordLength":8,"Password":"hunter2"}`,
+			want:        `{"Password":"[REDACTED]","PasswordLength":8}`,
+		},
+		{
+			name:        "JSON object value",
+			serviceName: "cognito-idp",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"UserPoolClient":{"ClientId":"abc","ClientSecret":{"Value":"hunter2","Nested":["hunter3"]}}}`,
+			want:        `{"UserPoolClient":{"ClientId":"abc","ClientSecret":"[REDACTED]"}}`,
+		},
+		{
+			name:        "JSON array value",
+			serviceName: "ecr",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"Token":["hunter2",{"Password":"hunter3"}],"Items":[{"Password":"hunter4","Name":"test"}]}`,
+			want:        `{"Items":[{"Name":"test","Password":"[REDACTED]"}],"Token":"[REDACTED]"}`,
+		},
+		{
+			name:        "JSON key case",
+			serviceName: "directconnect",
+			contentType: "application/json",
+			body:        `{"authKey":"abc","token":"hunter2","PASSWORD":"hunter3"}`,
+			want:        `{"PASSWORD":"[REDACTED]","authKey":"abc","token":"[REDACTED]"}`,
+		},
+		{
+			name:        "JSON HTML characters",
+			serviceName: "ssm",
+			contentType: "application/json",
+			body:        `{"Name":"<a&b>","Value":"hunter2"}`,
+			want:        `{"Name":"<a&b>","Value":"[REDACTED]"}`,
+		},
+		{
+			name:        "unparseable JSON",
+			serviceName: "rds",
+			contentType: "application/json",
+			body:        `{"Password":"hunter2"`,
+			want:        `[21 bytes of unparseable JSON omitted]`,
+		},
+		{
+			name:        "XML element case",
+			serviceName: "ec2",
+			contentType: "text/xml;charset=UTF-8",
+			body:        `<GetPasswordDataResponse><instanceId>i-1234</instanceId><passwordData>aHVudGVyMg==</passwordData></GetPasswordDataResponse>`,
+			want:        `<GetPasswordDataResponse><instanceId>i-1234</instanceId><passwordData>[REDACTED]</passwordData></GetPasswordDataResponse>`,
+		},
+		{
+			name:        "XML element with attributes and line breaks",
+			serviceName: "rds",
+			contentType: "text/xml; charset=UTF-8",
+			body:        "<MasterUserPassword xmlns=\"x\">hunter2\nhunter3</MasterUserPassword><MasterUsername>admin</MasterUsername>",
+			want:        `<MasterUserPassword xmlns="x">[REDACTED]</MasterUserPassword><MasterUsername>admin</MasterUsername>`,
+		},
+		{
+			name:        "other content type",
+			serviceName: "s3",
+			contentType: "application/octet-stream",
+			body:        "hunter2",
+			want:        `[7 bytes of "application/octet-stream" omitted]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := DefaultRedactions().Redact(testCase.serviceName, testCase.contentType, []byte(testCase.body))
+
+			if got != testCase.want {
+				t.Errorf("got %s, expected %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestDefaultRedactions(t *testing.T) {
+	const secret = "hunter2"
+
+	redactions := DefaultRedactions()
+
+	for serviceName, fields := range redactions {
+		if serviceName == AllServices {
+			serviceName = "test"
+		}
+
+		for _, field := range fields {
+			formats := map[string]string{
+				"application/x-amz-json-1.1":        fmt.Sprintf(`{"Outer":{%q:%q}}`, field, secret),
+				"text/xml":                          fmt.Sprintf(`<Outer><%s>%s</%s></Outer>`, field, secret, field),
+				"application/x-www-form-urlencoded": fmt.Sprintf(`Action=Test&%s=%s`, field, secret),
+			}
+
+			for contentType, body := range formats {
+				t.Run(fmt.Sprintf("%s/%s/%s", serviceName, field, contentType), func(t *testing.T) {
+					got := redactions.Redact(serviceName, contentType, []byte(body))
+
+					if strings.Contains(got, secret) {
+						t.Errorf("secret not redacted: %s", got)
+					}
+
+					if !strings.Contains(got, "REDACTED") {
+						t.Errorf("redacted value missing: %s", got)
+					}
+				})
+			}
+		}
+	}
+}
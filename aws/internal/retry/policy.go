@@ -0,0 +1,193 @@
+package retry
+
+import (
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+// DefaultPolicy returns the service-specific retry rules applied to every provider service client.
+func DefaultPolicy() Policy {
+	// When calling Config Organization Rules API actions immediately
+	// after Organization creation, the API can randomly return the
+	// OrganizationAccessDeniedException error for a few minutes, even
+	// after succeeding a few requests.
+	// We only want to retry briefly as the default max retry count would
+	// excessively retry when the error could be legitimate.
+	// ~10 retries gives a fair backoff of a few seconds.
+	configOrganizationRetries := 9
+
+	return Policy{
+		apigateway.ServiceID: {
+			// Many operations can return an error such as:
+			//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+			// Handle them all globally for the service client.
+			{
+				Code:    apigateway.ErrCodeConflictException,
+				Message: "try again later",
+			},
+		},
+		applicationautoscaling.ServiceID: {
+			// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+			{
+				Operations: []string{"Describe*", "List*"},
+				Code:       applicationautoscaling.ErrCodeFailedResourceAccessException,
+			},
+		},
+		appsync.ServiceID: {
+			{
+				Operations: []string{"CreateGraphqlApi"},
+				Code:       appsync.ErrCodeConcurrentModificationException,
+				Message:    "a GraphQL API creation is already in progress",
+			},
+		},
+		cloudhsmv2.ServiceID: {
+			{
+				Code:    cloudhsmv2.ErrCodeCloudHsmInternalFailureException,
+				Message: "request was rejected because of an AWS CloudHSM internal failure",
+			},
+		},
+		configservice.ServiceID: {
+			{
+				Operations: []string{"DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule"},
+				Code:       configservice.ErrCodeOrganizationAccessDeniedException,
+				Message:    "This action can be only made by AWS Organization's master account.",
+				MaxRetries: configOrganizationRetries,
+			},
+			{
+				Operations: []string{"DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack"},
+				Code:       configservice.ErrCodeOrganizationAccessDeniedException,
+				MaxRetries: configOrganizationRetries,
+			},
+			{
+				Operations: []string{"DeleteOrganizationConformancePack"},
+				Code:       configservice.ErrCodeResourceInUseException,
+			},
+		},
+		dynamodb.ServiceID: {
+			// See https://github.com/aws/aws-sdk-go/pull/1276
+			{
+				Operations: []string{"PutItem", "UpdateItem", "DeleteItem"},
+				Code:       dynamodb.ErrCodeLimitExceededException,
+				Message:    "Subscriber limit exceeded:",
+			},
+		},
+		ec2.ServiceID: {
+			{
+				Operations: []string{"CreateClientVpnEndpoint"},
+				Code:       "OperationNotPermitted",
+				Message:    "Endpoint cannot be created while another endpoint is being created",
+			},
+			{
+				Operations: []string{"CreateVpnConnection"},
+				Code:       "VpnConnectionLimitExceeded",
+				Message:    "maximum number of mutating objects has been reached",
+			},
+			{
+				Operations: []string{"CreateVpnGateway"},
+				Code:       "VpnGatewayLimitExceeded",
+				Message:    "maximum number of mutating objects has been reached",
+			},
+			{
+				Operations: []string{"AttachVpnGateway", "DetachVpnGateway"},
+				Code:       "InvalidParameterValue",
+				Message:    "This call cannot be completed because there are pending VPNs or Virtual Interfaces",
+			},
+		},
+		fms.ServiceID: {
+			// Acceptance testing creates and deletes resources in quick succession.
+			// The FMS onboarding process into Organizations is opaque to consumers.
+			// Since we cannot reasonably check this status before receiving the error,
+			// set the operation as retryable.
+			{
+				Operations: []string{"AssociateAdminAccount"},
+				Code:       fms.ErrCodeInvalidOperationException,
+				Message:    "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.",
+			},
+			{
+				Operations: []string{"DisassociateAdminAccount"},
+				Code:       fms.ErrCodeInvalidOperationException,
+				Message:    "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.",
+			},
+		},
+		kafka.ServiceID: {
+			{
+				Code:    kafka.ErrCodeTooManyRequestsException,
+				Message: "Too Many Requests",
+			},
+		},
+		kinesis.ServiceID: {
+			{
+				Operations: []string{"CreateStream"},
+				Code:       kinesis.ErrCodeLimitExceededException,
+				Message:    "simultaneously be in CREATING or DELETING",
+			},
+			{
+				Operations: []string{"CreateStream", "DeleteStream"},
+				Code:       kinesis.ErrCodeLimitExceededException,
+				Message:    "Rate exceeded for stream",
+			},
+		},
+		organizations.ServiceID: {
+			// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+			{
+				Code:    organizations.ErrCodeConcurrentModificationException,
+				Message: "Try again later",
+			},
+		},
+		securityhub.ServiceID: {
+			// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+			{
+				Operations: []string{"EnableOrganizationAdminAccount"},
+				Code:       securityhub.ErrCodeResourceConflictException,
+			},
+		},
+		ssoadmin.ServiceID: {
+			// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+			{
+				Operations: []string{"AttachManagedPolicyToPermissionSet", "DetachManagedPolicyFromPermissionSet"},
+				Code:       ssoadmin.ErrCodeConflictException,
+			},
+		},
+		storagegateway.ServiceID: {
+			// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+			{
+				Code:    storagegateway.ErrCodeInvalidGatewayRequestException,
+				Message: "The specified gateway proxy network connection is busy",
+			},
+		},
+		wafv2.ServiceID: {
+			{
+				Code:    wafv2.ErrCodeWAFInternalErrorException,
+				Message: "Retry your request",
+			},
+			{
+				Code:    wafv2.ErrCodeWAFServiceLinkedRoleErrorException,
+				Message: "Retry",
+			},
+			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+			{
+				Operations: []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+				Code:       wafv2.ErrCodeWAFTagOperationException,
+				Message:    "Retry your request",
+			},
+			{
+				Operations: []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+				Code:       wafv2.ErrCodeWAFTagOperationInternalErrorException,
+				Message:    "Retry your request",
+			},
+		},
+	}
+}
@@ -0,0 +1,235 @@
+package retry
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// rateLimiterMinFillRate is the lowest send rate, in requests per second,
+	// that throttling can reduce a rate limiter to.
+	rateLimiterMinFillRate = 0.5
+
+	// rateLimiterBeta is the factor applied to the send rate after a throttling error.
+	rateLimiterBeta = 0.7
+
+	// rateLimiterIncrement is the send rate increase, in requests per second,
+	// applied after each successful request once throttling has been observed.
+	rateLimiterIncrement = 0.5
+
+	// rateLimiterSmooth is the weight given to the latest measured send rate.
+	rateLimiterSmooth = 0.8
+
+	// rateLimiterBucketDuration is the window over which the send rate is measured.
+	rateLimiterBucketDuration = 500 * time.Millisecond
+)
+
+// RateLimiter is a client-side token bucket for the requests sent to a single service.
+// The bucket is disabled until the service first throttles a request, so unthrottled
+// workloads pay no cost. Each throttling error then cuts the fill rate multiplicatively
+// and each successful request increases it additively, capped at twice the measured rate.
+// A RateLimiter is safe for concurrent use.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	enabled  bool
+	capacity float64
+	fillRate float64
+	tokens   float64
+	lastFill time.Time
+
+	measuredRate float64
+	bucketStart  time.Time
+	bucketCount  int
+
+	now   func() time.Time
+	sleep func(aws.Context, time.Duration) error
+}
+
+// NewRateLimiter returns a new, disabled, RateLimiter.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		now:   time.Now,
+		sleep: aws.SleepWithContext,
+	}
+}
+
+// Acquire blocks until a token is available or the context is done.
+func (l *RateLimiter) Acquire(ctx aws.Context) error {
+	for {
+		l.mu.Lock()
+
+		if !l.enabled {
+			l.mu.Unlock()
+
+			return nil
+		}
+
+		l.refill()
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+
+			return nil
+		}
+
+		wait := time.Duration((1 - l.tokens) / l.fillRate * float64(time.Second))
+		l.mu.Unlock()
+
+		if err := l.sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// Throttled records a request that was throttled by the service, enabling the
+// rate limiter if necessary and reducing its fill rate.
+func (l *RateLimiter) Throttled() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.measure()
+
+	rate := l.measuredRate
+
+	// No full measurement window has elapsed yet, e.g. a burst of requests at start up.
+	if rate == 0 {
+		rate = float64(l.bucketCount) / rateLimiterBucketDuration.Seconds()
+	}
+
+	if l.enabled {
+		l.refill()
+		rate = l.fillRate
+	} else {
+		l.enabled = true
+		l.lastFill = l.now()
+	}
+
+	l.setFillRate(rate * rateLimiterBeta)
+}
+
+// Succeeded records a request that completed without being throttled.
+func (l *RateLimiter) Succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.measure()
+
+	if !l.enabled {
+		return
+	}
+
+	l.refill()
+	l.setFillRate(math.Min(l.fillRate+rateLimiterIncrement, math.Max(2*l.measuredRate, rateLimiterMinFillRate)))
+}
+
+// FillRate returns the current fill rate in requests per second and whether the rate limiter is enabled.
+func (l *RateLimiter) FillRate() (float64, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.fillRate, l.enabled
+}
+
+func (l *RateLimiter) setFillRate(rate float64) {
+	l.fillRate = math.Max(rate, rateLimiterMinFillRate)
+	l.capacity = math.Max(l.fillRate, 1)
+	l.tokens = math.Min(l.tokens, l.capacity)
+}
+
+func (l *RateLimiter) refill() {
+	now := l.now()
+
+	l.tokens = math.Min(l.capacity, l.tokens+now.Sub(l.lastFill).Seconds()*l.fillRate)
+	l.lastFill = now
+}
+
+func (l *RateLimiter) measure() {
+	now := l.now()
+	l.bucketCount++
+
+	if l.bucketStart.IsZero() {
+		l.bucketStart = now.Truncate(rateLimiterBucketDuration)
+
+		return
+	}
+
+	bucket := now.Truncate(rateLimiterBucketDuration)
+
+	if !bucket.After(l.bucketStart) {
+		return
+	}
+
+	rate := float64(l.bucketCount) / bucket.Sub(l.bucketStart).Seconds()
+	l.measuredRate = rate*rateLimiterSmooth + l.measuredRate*(1-rateLimiterSmooth)
+	l.bucketCount = 0
+	l.bucketStart = bucket
+}
+
+// RateLimiters holds a RateLimiter for each AWS service, shared by every
+// service client created from the same provider configuration.
+type RateLimiters struct {
+	mu       sync.Mutex
+	limiters map[string]*RateLimiter
+}
+
+// NewRateLimiters returns an empty set of per-service rate limiters.
+func NewRateLimiters() *RateLimiters {
+	return &RateLimiters{
+		limiters: make(map[string]*RateLimiter),
+	}
+}
+
+// Get returns the RateLimiter for the specified service identifier, creating it if necessary.
+func (l *RateLimiters) Get(serviceID string) *RateLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.limiters[serviceID]
+
+	if !ok {
+		limiter = NewRateLimiter()
+		l.limiters[serviceID] = limiter
+	}
+
+	return limiter
+}
+
+// SendHandler returns a request handler that waits for a token before each request attempt.
+// The handler should be added to the front of the Send handler list, and the list should
+// stop on error (request.HandlerListStopOnError) so that the request is not sent when
+// no token can be acquired.
+func (l *RateLimiters) SendHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimiterSendHandler",
+		Fn: func(r *request.Request) {
+			if err := l.Get(r.ClientInfo.ServiceID).Acquire(r.Context()); err != nil {
+				r.Error = err
+			}
+		},
+	}
+}
+
+// CompleteAttemptHandler returns a request handler that adjusts the service
+// send rate based on the outcome of each request attempt.
+// The handler should be added to the CompleteAttempt handler list.
+func (l *RateLimiters) CompleteAttemptHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimiterCompleteAttemptHandler",
+		Fn: func(r *request.Request) {
+			limiter := l.Get(r.ClientInfo.ServiceID)
+
+			switch {
+			case r.Error == nil:
+				limiter.Succeeded()
+			case request.IsErrorThrottle(r.Error):
+				limiter.Throttled()
+			}
+		},
+	}
+}
@@ -0,0 +1,182 @@
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+type testClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Sleep(ctx aws.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+
+	return nil
+}
+
+func testRateLimiter(clock *testClock) *RateLimiter {
+	limiter := NewRateLimiter()
+	limiter.now = clock.Now
+	limiter.sleep = clock.Sleep
+
+	return limiter
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	clock := &testClock{now: time.Unix(1600000000, 0)}
+	limiter := testRateLimiter(clock)
+
+	for i := 0; i < 100; i++ {
+		if err := limiter.Acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		limiter.Succeeded()
+	}
+
+	if _, enabled := limiter.FillRate(); enabled {
+		t.Errorf("expected rate limiter to be disabled")
+	}
+
+	if len(clock.sleeps) != 0 {
+		t.Errorf("expected no sleeps, got %d", len(clock.sleeps))
+	}
+}
+
+func TestRateLimiterThrottled(t *testing.T) {
+	clock := &testClock{now: time.Unix(1600000000, 0)}
+	limiter := testRateLimiter(clock)
+
+	// 10 requests per second for 2 seconds.
+	for i := 0; i < 20; i++ {
+		limiter.Succeeded()
+		clock.now = clock.now.Add(100 * time.Millisecond)
+	}
+
+	limiter.Throttled()
+
+	rate, enabled := limiter.FillRate()
+
+	if !enabled {
+		t.Fatalf("expected rate limiter to be enabled")
+	}
+
+	if rate <= rateLimiterMinFillRate || rate >= 10 {
+		t.Errorf("got fill rate %f, expected between %f and 10", rate, rateLimiterMinFillRate)
+	}
+
+	limiter.Throttled()
+
+	if got, _ := limiter.FillRate(); got >= rate {
+		t.Errorf("got fill rate %f, expected less than %f", got, rate)
+	}
+
+	for i := 0; i < 100; i++ {
+		limiter.Throttled()
+	}
+
+	if got, _ := limiter.FillRate(); got != rateLimiterMinFillRate {
+		t.Errorf("got fill rate %f, expected %f", got, rateLimiterMinFillRate)
+	}
+}
+
+func TestRateLimiterSucceeded(t *testing.T) {
+	clock := &testClock{now: time.Unix(1600000000, 0)}
+	limiter := testRateLimiter(clock)
+
+	for i := 0; i < 20; i++ {
+		limiter.Succeeded()
+		clock.now = clock.now.Add(100 * time.Millisecond)
+	}
+
+	limiter.Throttled()
+	throttledRate, _ := limiter.FillRate()
+
+	limiter.Succeeded()
+
+	if got, _ := limiter.FillRate(); got <= throttledRate {
+		t.Errorf("got fill rate %f, expected greater than %f", got, throttledRate)
+	}
+
+	for i := 0; i < 1000; i++ {
+		limiter.Succeeded()
+		clock.now = clock.now.Add(100 * time.Millisecond)
+	}
+
+	// Capped at twice the measured rate of 10 requests per second.
+	if got, _ := limiter.FillRate(); got > 20 {
+		t.Errorf("got fill rate %f, expected at most 20", got)
+	}
+}
+
+func TestRateLimiterAcquire(t *testing.T) {
+	clock := &testClock{now: time.Unix(1600000000, 0)}
+	limiter := testRateLimiter(clock)
+
+	for i := 0; i < 10; i++ {
+		limiter.Throttled()
+	}
+
+	rate, _ := limiter.FillRate()
+
+	if rate != rateLimiterMinFillRate {
+		t.Fatalf("got fill rate %f, expected %f", rate, rateLimiterMinFillRate)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := limiter.Acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	var slept time.Duration
+
+	for _, d := range clock.sleeps {
+		slept += d
+	}
+
+	// 3 tokens at 0.5 tokens per second from an empty bucket.
+	if expected := 6 * time.Second; slept != expected {
+		t.Errorf("got %s total sleep, expected %s", slept, expected)
+	}
+}
+
+func TestRateLimiterAcquireContextDone(t *testing.T) {
+	clock := &testClock{now: time.Unix(1600000000, 0)}
+	limiter := testRateLimiter(clock)
+
+	limiter.Throttled()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := limiter.Acquire(ctx); err == nil {
+		t.Errorf("expected error, got none")
+	}
+}
+
+func TestRateLimitersGet(t *testing.T) {
+	limiters := NewRateLimiters()
+
+	if limiters.Get("EC2") != limiters.Get("EC2") {
+		t.Errorf("expected the same rate limiter for the same service")
+	}
+
+	if limiters.Get("EC2") == limiters.Get("Organizations") {
+		t.Errorf("expected different rate limiters for different services")
+	}
+}
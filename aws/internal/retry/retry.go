@@ -0,0 +1,99 @@
+package retry
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+const (
+	// ModeStandard retries failed requests using the AWS SDK default retryer
+	// and the service-specific rules in the provider retry policy.
+	ModeStandard = "standard"
+
+	// ModeAdaptive extends ModeStandard with client-side rate limiting
+	// that backs off when a service starts throttling requests.
+	ModeAdaptive = "adaptive"
+)
+
+// Modes returns the supported provider retry modes.
+func Modes() []string {
+	return []string{
+		ModeStandard,
+		ModeAdaptive,
+	}
+}
+
+// Rule describes a service-specific error that should be retried.
+type Rule struct {
+	// Operations limits the rule to the named API operations.
+	// A trailing "*" matches any operation with that prefix.
+	// An empty list matches every operation.
+	Operations []string
+
+	// Code is the AWS error code that must be returned.
+	Code string
+
+	// Message is a substring that must be present in the AWS error message.
+	// An empty value matches any message.
+	Message string
+
+	// MaxRetries limits the number of retries triggered by the rule, for errors
+	// that may also be legitimate. A zero value defers to the client retryer.
+	MaxRetries int
+}
+
+// Matches returns whether the rule applies to the specified operation and error.
+func (rule Rule) Matches(operation string, err error) bool {
+	if !tfawserr.ErrMessageContains(err, rule.Code, rule.Message) {
+		return false
+	}
+
+	if len(rule.Operations) == 0 {
+		return true
+	}
+
+	for _, v := range rule.Operations {
+		if prefix := strings.TrimSuffix(v, "*"); prefix != v {
+			if strings.HasPrefix(operation, prefix) {
+				return true
+			}
+		} else if operation == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Policy maps an AWS service identifier (e.g. ec2.ServiceID) to the rules for that service.
+type Policy map[string][]Rule
+
+// Handler returns a request handler that marks requests retryable when a rule in the policy matches.
+// The handler should be added to the Retry handler list of a session or service client.
+func (policy Policy) Handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform-provider-aws.RetryPolicyHandler",
+		Fn: func(r *request.Request) {
+			if r.Error == nil || r.Operation == nil {
+				return
+			}
+
+			for _, rule := range policy[r.ClientInfo.ServiceID] {
+				if !rule.Matches(r.Operation.Name, r.Error) {
+					continue
+				}
+
+				if rule.MaxRetries > 0 && r.RetryCount >= rule.MaxRetries {
+					r.Retryable = aws.Bool(false)
+				} else {
+					r.Retryable = aws.Bool(true)
+				}
+
+				return
+			}
+		},
+	}
+}
@@ -0,0 +1,345 @@
+package retry_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	tfretry "github.com/terraform-providers/terraform-provider-aws/aws/internal/retry"
+)
+
+func TestRuleMatches(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Rule      tfretry.Rule
+		Operation string
+		Err       error
+		Expected  bool
+	}{
+		{
+			Name:      "nil error",
+			Rule:      tfretry.Rule{Code: "TestCode"},
+			Operation: "CreateThing",
+			Err:       nil,
+			Expected:  false,
+		},
+		{
+			Name:      "non-AWS error",
+			Rule:      tfretry.Rule{Code: "TestCode"},
+			Operation: "CreateThing",
+			Err:       fmt.Errorf("TestCode"),
+			Expected:  false,
+		},
+		{
+			Name:      "code match any operation",
+			Rule:      tfretry.Rule{Code: "TestCode"},
+			Operation: "CreateThing",
+			Err:       awserr.New("TestCode", "TestMessage", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "code mismatch",
+			Rule:      tfretry.Rule{Code: "TestCode"},
+			Operation: "CreateThing",
+			Err:       awserr.New("OtherCode", "TestMessage", nil),
+			Expected:  false,
+		},
+		{
+			Name:      "message match",
+			Rule:      tfretry.Rule{Code: "TestCode", Message: "try again"},
+			Operation: "CreateThing",
+			Err:       awserr.New("TestCode", "Please try again later", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "message mismatch",
+			Rule:      tfretry.Rule{Code: "TestCode", Message: "try again"},
+			Operation: "CreateThing",
+			Err:       awserr.New("TestCode", "Not authorized", nil),
+			Expected:  false,
+		},
+		{
+			Name:      "operation match",
+			Rule:      tfretry.Rule{Operations: []string{"DeleteThing", "CreateThing"}, Code: "TestCode"},
+			Operation: "CreateThing",
+			Err:       awserr.New("TestCode", "TestMessage", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "operation mismatch",
+			Rule:      tfretry.Rule{Operations: []string{"DeleteThing"}, Code: "TestCode"},
+			Operation: "CreateThing",
+			Err:       awserr.New("TestCode", "TestMessage", nil),
+			Expected:  false,
+		},
+		{
+			Name:      "operation prefix match",
+			Rule:      tfretry.Rule{Operations: []string{"Describe*", "List*"}, Code: "TestCode"},
+			Operation: "ListThings",
+			Err:       awserr.New("TestCode", "TestMessage", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "operation prefix mismatch",
+			Rule:      tfretry.Rule{Operations: []string{"Describe*", "List*"}, Code: "TestCode"},
+			Operation: "CreateThing",
+			Err:       awserr.New("TestCode", "TestMessage", nil),
+			Expected:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.Rule.Matches(testCase.Operation, testCase.Err)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPolicyHandler(t *testing.T) {
+	vpnGatewayLimitExceeded := testEc2ErrorResponse(http.StatusBadRequest, "VpnGatewayLimitExceeded", "The maximum number of mutating objects has been reached.")
+	createVpnGatewaySuccess := &testResponse{
+		StatusCode: http.StatusOK,
+		Body:       `<CreateVpnGatewayResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId><vpnGateway><vpnGatewayId>vgw-12345678</vpnGatewayId></vpnGateway></CreateVpnGatewayResponse>`,
+	}
+
+	testCases := []struct {
+		Name             string
+		Policy           tfretry.Policy
+		Responses        []*testResponse
+		ExpectedAttempts int
+		ExpectError      bool
+	}{
+		{
+			Name:             "no policy",
+			Policy:           nil,
+			Responses:        []*testResponse{vpnGatewayLimitExceeded, createVpnGatewaySuccess},
+			ExpectedAttempts: 1,
+			ExpectError:      true,
+		},
+		{
+			Name:             "default policy",
+			Policy:           tfretry.DefaultPolicy(),
+			Responses:        []*testResponse{vpnGatewayLimitExceeded, vpnGatewayLimitExceeded, createVpnGatewaySuccess},
+			ExpectedAttempts: 3,
+			ExpectError:      false,
+		},
+		{
+			Name: "other operation",
+			Policy: tfretry.Policy{
+				ec2.ServiceID: {
+					{Operations: []string{"CreateVpnConnection"}, Code: "VpnGatewayLimitExceeded"},
+				},
+			},
+			Responses:        []*testResponse{vpnGatewayLimitExceeded, createVpnGatewaySuccess},
+			ExpectedAttempts: 1,
+			ExpectError:      true,
+		},
+		{
+			Name: "rule max retries",
+			Policy: tfretry.Policy{
+				ec2.ServiceID: {
+					{Code: "VpnGatewayLimitExceeded", MaxRetries: 2},
+				},
+			},
+			Responses:        []*testResponse{vpnGatewayLimitExceeded, vpnGatewayLimitExceeded, vpnGatewayLimitExceeded, createVpnGatewaySuccess},
+			ExpectedAttempts: 3,
+			ExpectError:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			transport := &testTransport{Responses: testCase.Responses}
+			sess := testSession(t, transport)
+
+			if testCase.Policy != nil {
+				sess.Handlers.Retry.PushBackNamed(testCase.Policy.Handler())
+			}
+
+			_, err := ec2.New(sess).CreateVpnGateway(&ec2.CreateVpnGatewayInput{
+				Type: aws.String(ec2.GatewayTypeIpsec1),
+			})
+
+			if testCase.ExpectError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+
+			if !testCase.ExpectError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if got, expected := transport.Attempts(), testCase.ExpectedAttempts; got != expected {
+				t.Errorf("got %d attempts, expected %d", got, expected)
+			}
+		})
+	}
+}
+
+func TestRateLimitersHandlers(t *testing.T) {
+	transport := &testTransport{
+		Responses: []*testResponse{
+			testEc2ErrorResponse(http.StatusServiceUnavailable, "RequestLimitExceeded", "Request limit exceeded."),
+			{
+				StatusCode: http.StatusOK,
+				Body:       `<DescribeAccountAttributesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId><accountAttributeSet></accountAttributeSet></DescribeAccountAttributesResponse>`,
+			},
+		},
+	}
+	sess := testSession(t, transport)
+	limiters := tfretry.NewRateLimiters()
+
+	sess.Handlers.Send.PushFrontNamed(limiters.SendHandler())
+	sess.Handlers.Send.AfterEachFn = request.HandlerListStopOnError
+	sess.Handlers.CompleteAttempt.PushBackNamed(limiters.CompleteAttemptHandler())
+
+	if _, err := ec2.New(sess).DescribeAccountAttributes(&ec2.DescribeAccountAttributesInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := transport.Attempts(), 2; got != expected {
+		t.Errorf("got %d attempts, expected %d", got, expected)
+	}
+
+	if _, enabled := limiters.Get(ec2.ServiceID).FillRate(); !enabled {
+		t.Errorf("expected EC2 rate limiter to be enabled after throttling")
+	}
+
+	if _, enabled := limiters.Get("Organizations").FillRate(); enabled {
+		t.Errorf("expected Organizations rate limiter to be disabled")
+	}
+}
+
+func TestRateLimitersSendHandlerContextDone(t *testing.T) {
+	transport := &testTransport{
+		Responses: []*testResponse{
+			{
+				StatusCode: http.StatusOK,
+				Body:       `<DescribeAccountAttributesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId><accountAttributeSet></accountAttributeSet></DescribeAccountAttributesResponse>`,
+			},
+		},
+	}
+	sess := testSession(t, transport)
+	limiters := tfretry.NewRateLimiters()
+
+	sess.Handlers.Send.PushFrontNamed(limiters.SendHandler())
+	sess.Handlers.Send.AfterEachFn = request.HandlerListStopOnError
+
+	var sent bool
+
+	sess.Handlers.Send.PushBack(func(r *request.Request) {
+		sent = true
+	})
+
+	// Throttle the limiter so that the request must wait for a token.
+	limiter := limiters.Get(ec2.ServiceID)
+
+	for i := 0; i < 10; i++ {
+		limiter.Throttled()
+	}
+
+	// The deadline passes while the request waits for a token.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	if _, err := ec2.New(sess).DescribeAccountAttributesWithContext(ctx, &ec2.DescribeAccountAttributesInput{}); err == nil {
+		t.Fatalf("expected error, got none")
+	}
+
+	if sent {
+		t.Errorf("expected remaining Send handlers not to run")
+	}
+
+	if got, expected := transport.Attempts(), 0; got != expected {
+		t.Errorf("got %d attempts, expected %d", got, expected)
+	}
+}
+
+type testResponse struct {
+	StatusCode int
+	Body       string
+}
+
+func testEc2ErrorResponse(statusCode int, code, message string) *testResponse {
+	return &testResponse{
+		StatusCode: statusCode,
+		Body:       fmt.Sprintf(`<Response><Errors><Error><Code>%s</Code><Message>%s</Message></Error></Errors><RequestID>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestID></Response>`, code, message),
+	}
+}
+
+// testTransport is an http.RoundTripper that returns the configured responses in order,
+// repeating the last response once all others have been returned.
+type testTransport struct {
+	Responses []*testResponse
+
+	mu       sync.Mutex
+	attempts int
+}
+
+func (t *testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	response := t.Responses[len(t.Responses)-1]
+
+	if t.attempts < len(t.Responses) {
+		response = t.Responses[t.attempts]
+	}
+
+	t.attempts++
+
+	return &http.Response{
+		StatusCode: response.StatusCode,
+		Status:     http.StatusText(response.StatusCode),
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       ioutil.NopCloser(strings.NewReader(response.Body)),
+		Request:    req,
+	}, nil
+}
+
+func (t *testTransport) Attempts() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.attempts
+}
+
+func testSession(t *testing.T, transport http.RoundTripper) *session.Session {
+	// A custom CA bundle cannot be applied to the test transport.
+	t.Setenv("AWS_CA_BUNDLE", "")
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String("http://127.0.0.1"),
+		HTTPClient:  &http.Client{Transport: transport},
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+		Retryer: client.DefaultRetryer{
+			NumMaxRetries:    5,
+			MinRetryDelay:    time.Millisecond,
+			MaxRetryDelay:    time.Millisecond,
+			MinThrottleDelay: time.Millisecond,
+			MaxThrottleDelay: time.Millisecond,
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return sess
+}
@@ -10,3 +10,12 @@ const (
 	VpcEndpointStatePendingAcceptance = "pendingAcceptance"
 	VpcEndpointStateRejected          = "rejected"
 )
+
+const (
+	LaunchTemplateVersionDefault = "$Default"
+	LaunchTemplateVersionLatest  = "$Latest"
+)
+
+const (
+	IpamPoolLocaleNone = "None"
+)
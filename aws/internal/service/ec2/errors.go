@@ -21,6 +21,18 @@ const (
 	ErrCodeInvalidCarrierGatewayIDNotFound = "InvalidCarrierGatewayID.NotFound"
 )
 
+const (
+	ErrCodeInvalidIpamIdNotFound               = "InvalidIpamId.NotFound"
+	ErrCodeInvalidIpamPoolAllocationIdNotFound = "InvalidIpamPoolAllocationId.NotFound"
+	ErrCodeInvalidIpamPoolIdNotFound           = "InvalidIpamPoolId.NotFound"
+	ErrCodeInvalidIpamScopeIdNotFound          = "InvalidIpamScopeId.NotFound"
+)
+
+const (
+	ErrCodeInvalidLaunchTemplateIdNotFound            = "InvalidLaunchTemplateId.NotFound"
+	ErrCodeInvalidLaunchTemplateNameNotFoundException = "InvalidLaunchTemplateName.NotFoundException"
+)
+
 const (
 	ErrCodeInvalidNetworkInterfaceIDNotFound = "InvalidNetworkInterfaceID.NotFound"
 )
@@ -36,7 +48,10 @@ const (
 )
 
 const (
-	ErrCodeInvalidTransitGatewayIDNotFound = "InvalidTransitGatewayID.NotFound"
+	ErrCodeInvalidTransitGatewayAttachmentIDNotFound      = "InvalidTransitGatewayAttachmentID.NotFound"
+	ErrCodeInvalidTransitGatewayConnectPeerIDNotFound     = "InvalidTransitGatewayConnectPeerID.NotFound"
+	ErrCodeInvalidTransitGatewayIDNotFound                = "InvalidTransitGatewayID.NotFound"
+	ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound = "InvalidTransitGatewayMulticastDomainId.NotFound"
 )
 
 const (
@@ -55,6 +70,10 @@ const (
 	InvalidGroupNotFound           = "InvalidGroup.NotFound"
 )
 
+const (
+	ErrCodeInvalidSecurityGroupRuleIdNotFound = "InvalidSecurityGroupRuleId.NotFound"
+)
+
 const (
 	ErrCodeInvalidSpotInstanceRequestIDNotFound = "InvalidSpotInstanceRequestID.NotFound"
 )
@@ -98,6 +98,356 @@ func InstanceByID(conn *ec2.EC2, id string) (*ec2.Instance, error) {
 	return output.Reservations[0].Instances[0], nil
 }
 
+// IpamByID looks up an IPAM by ID. Returns a resource.NotFoundError if not found.
+func IpamByID(conn *ec2.EC2, id string) (*ec2.Ipam, error) {
+	input := &ec2.DescribeIpamsInput{
+		IpamIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := Ipam(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.IpamStateDeleteComplete {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.IpamId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// Ipam looks up an IPAM using an ec2.DescribeIpamsInput. Returns a resource.NotFoundError if not found.
+func Ipam(conn *ec2.EC2, input *ec2.DescribeIpamsInput) (*ec2.Ipam, error) {
+	output, err := conn.DescribeIpams(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Ipams) == 0 || output.Ipams[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output.Ipams) > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("too many results: wanted 1, got %d", len(output.Ipams)),
+			LastRequest: input,
+		}
+	}
+
+	return output.Ipams[0], nil
+}
+
+// IpamPoolByID looks up an IPAM pool by ID. Returns a resource.NotFoundError if not found.
+func IpamPoolByID(conn *ec2.EC2, id string) (*ec2.IpamPool, error) {
+	input := &ec2.DescribeIpamPoolsInput{
+		IpamPoolIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := IpamPool(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.IpamPoolStateDeleteComplete {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.IpamPoolId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// IpamPool looks up an IPAM pool using an ec2.DescribeIpamPoolsInput. Returns a resource.NotFoundError if not found.
+func IpamPool(conn *ec2.EC2, input *ec2.DescribeIpamPoolsInput) (*ec2.IpamPool, error) {
+	output, err := conn.DescribeIpamPools(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamPoolIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.IpamPools) == 0 || output.IpamPools[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output.IpamPools) > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("too many results: wanted 1, got %d", len(output.IpamPools)),
+			LastRequest: input,
+		}
+	}
+
+	return output.IpamPools[0], nil
+}
+
+// IpamPoolAllocationByIDAndPoolID looks up an IPAM pool allocation by allocation ID and pool ID.
+// Returns a resource.NotFoundError if not found.
+func IpamPoolAllocationByIDAndPoolID(conn *ec2.EC2, allocationID, poolID string) (*ec2.IpamPoolAllocation, error) {
+	input := &ec2.GetIpamPoolAllocationsInput{
+		IpamPoolAllocationId: aws.String(allocationID),
+		IpamPoolId:           aws.String(poolID),
+	}
+
+	output, err := conn.GetIpamPoolAllocations(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamPoolAllocationIdNotFound) ||
+		tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamPoolIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.IpamPoolAllocations) == 0 || output.IpamPoolAllocations[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output.IpamPoolAllocations) > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("too many results: wanted 1, got %d", len(output.IpamPoolAllocations)),
+			LastRequest: input,
+		}
+	}
+
+	return output.IpamPoolAllocations[0], nil
+}
+
+// IpamPoolCidrByPoolIDAndCidr looks up a CIDR provisioned to an IPAM pool.
+// Returns a resource.NotFoundError if not found or if the CIDR has been deprovisioned.
+func IpamPoolCidrByPoolIDAndCidr(conn *ec2.EC2, poolID, cidr string) (*ec2.IpamPoolCidr, error) {
+	input := &ec2.GetIpamPoolCidrsInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"cidr": cidr,
+		}),
+		IpamPoolId: aws.String(poolID),
+	}
+
+	output, err := conn.GetIpamPoolCidrs(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamPoolIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.IpamPoolCidrs) == 0 || output.IpamPoolCidrs[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output.IpamPoolCidrs) > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("too many results: wanted 1, got %d", len(output.IpamPoolCidrs)),
+			LastRequest: input,
+		}
+	}
+
+	ipamPoolCidr := output.IpamPoolCidrs[0]
+
+	if state := aws.StringValue(ipamPoolCidr.State); state == ec2.IpamPoolCidrStateDeprovisioned {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return ipamPoolCidr, nil
+}
+
+// IpamScopeByID looks up an IPAM scope by ID. Returns a resource.NotFoundError if not found.
+func IpamScopeByID(conn *ec2.EC2, id string) (*ec2.IpamScope, error) {
+	input := &ec2.DescribeIpamScopesInput{
+		IpamScopeIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := IpamScope(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.IpamScopeStateDeleteComplete {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.IpamScopeId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// IpamScope looks up an IPAM scope using an ec2.DescribeIpamScopesInput. Returns a resource.NotFoundError if not found.
+func IpamScope(conn *ec2.EC2, input *ec2.DescribeIpamScopesInput) (*ec2.IpamScope, error) {
+	output, err := conn.DescribeIpamScopes(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamScopeIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.IpamScopes) == 0 || output.IpamScopes[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output.IpamScopes) > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("too many results: wanted 1, got %d", len(output.IpamScopes)),
+			LastRequest: input,
+		}
+	}
+
+	return output.IpamScopes[0], nil
+}
+
+// LaunchTemplateByID looks up a launch template by ID. Returns a resource.NotFoundError if not found.
+func LaunchTemplateByID(conn *ec2.EC2, id string) (*ec2.LaunchTemplate, error) {
+	input := &ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: aws.StringSlice([]string{id}),
+	}
+
+	return LaunchTemplate(conn, input)
+}
+
+// LaunchTemplate looks up a launch template using an ec2.DescribeLaunchTemplatesInput. Returns a resource.NotFoundError if not found.
+func LaunchTemplate(conn *ec2.EC2, input *ec2.DescribeLaunchTemplatesInput) (*ec2.LaunchTemplate, error) {
+	output, err := conn.DescribeLaunchTemplates(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidLaunchTemplateIdNotFound) ||
+		tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidLaunchTemplateNameNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.LaunchTemplates) == 0 || output.LaunchTemplates[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output.LaunchTemplates) > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("too many results: wanted 1, got %d", len(output.LaunchTemplates)),
+			LastRequest: input,
+		}
+	}
+
+	return output.LaunchTemplates[0], nil
+}
+
+// LaunchTemplateVersion looks up a launch template version by launch template ID or name and version.
+// Returns a resource.NotFoundError if not found.
+func LaunchTemplateVersion(conn *ec2.EC2, id, name, version string) (*ec2.LaunchTemplateVersion, error) {
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		Versions: aws.StringSlice([]string{version}),
+	}
+
+	if id != "" {
+		input.LaunchTemplateId = aws.String(id)
+	} else {
+		input.LaunchTemplateName = aws.String(name)
+	}
+
+	output, err := conn.DescribeLaunchTemplateVersions(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidLaunchTemplateIdNotFound) ||
+		tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidLaunchTemplateNameNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.LaunchTemplateVersions) == 0 || output.LaunchTemplateVersions[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.LaunchTemplateVersions[0], nil
+}
+
 // NetworkAclByID looks up a NetworkAcl by ID. When not found, returns nil and potentially an API error.
 func NetworkAclByID(conn *ec2.EC2, id string) (*ec2.NetworkAcl, error) {
 	input := &ec2.DescribeNetworkAclsInput{
@@ -459,22 +809,101 @@ func SecurityGroup(conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) (*ec2.
 	return result.SecurityGroups[0], nil
 }
 
-// SpotInstanceRequestByID looks up a SpotInstanceRequest by ID. When not found, returns nil and potentially an API error.
-func SpotInstanceRequestByID(conn *ec2.EC2, id string) (*ec2.SpotInstanceRequest, error) {
-	input := &ec2.DescribeSpotInstanceRequestsInput{
-		SpotInstanceRequestIds: aws.StringSlice([]string{id}),
+// SecurityGroupRuleByID looks up a security group rule by ID. Returns a resource.NotFoundError if not found.
+func SecurityGroupRuleByID(conn *ec2.EC2, id string) (*ec2.SecurityGroupRule, error) {
+	input := &ec2.DescribeSecurityGroupRulesInput{
+		SecurityGroupRuleIds: aws.StringSlice([]string{id}),
 	}
 
-	output, err := conn.DescribeSpotInstanceRequests(input)
+	output, err := SecurityGroupRule(conn, input)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if output == nil {
-		return nil, nil
-	}
-
+	// Eventual consistency check.
+	if aws.StringValue(output.SecurityGroupRuleId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// SecurityGroupRule looks up a security group rule using an ec2.DescribeSecurityGroupRulesInput. Returns a resource.NotFoundError if not found.
+func SecurityGroupRule(conn *ec2.EC2, input *ec2.DescribeSecurityGroupRulesInput) (*ec2.SecurityGroupRule, error) {
+	output, err := SecurityGroupRules(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output) > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("too many results: wanted 1, got %d", len(output)),
+			LastRequest: input,
+		}
+	}
+
+	return output[0], nil
+}
+
+// SecurityGroupRules looks up security group rules using an ec2.DescribeSecurityGroupRulesInput. Returns a resource.NotFoundError if the API reports the rules as not found.
+func SecurityGroupRules(conn *ec2.EC2, input *ec2.DescribeSecurityGroupRulesInput) ([]*ec2.SecurityGroupRule, error) {
+	var output []*ec2.SecurityGroupRule
+
+	err := conn.DescribeSecurityGroupRulesPages(input, func(page *ec2.DescribeSecurityGroupRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityGroupRules {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidSecurityGroupRuleIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// SpotInstanceRequestByID looks up a SpotInstanceRequest by ID. When not found, returns nil and potentially an API error.
+func SpotInstanceRequestByID(conn *ec2.EC2, id string) (*ec2.SpotInstanceRequest, error) {
+	input := &ec2.DescribeSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeSpotInstanceRequests(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
 	for _, spotInstanceRequest := range output.SpotInstanceRequests {
 		if spotInstanceRequest == nil {
 			continue
@@ -509,6 +938,365 @@ func SubnetByID(conn *ec2.EC2, id string) (*ec2.Subnet, error) {
 	return output.Subnets[0], nil
 }
 
+// TransitGatewayConnectByID looks up a Transit Gateway Connect attachment by ID. Returns a resource.NotFoundError if not found.
+func TransitGatewayConnectByID(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnect, error) {
+	input := &ec2.DescribeTransitGatewayConnectsInput{
+		TransitGatewayAttachmentIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := TransitGatewayConnect(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.TransitGatewayAttachmentStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.TransitGatewayAttachmentId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// TransitGatewayConnect looks up a Transit Gateway Connect attachment using an ec2.DescribeTransitGatewayConnectsInput. Returns a resource.NotFoundError if not found.
+func TransitGatewayConnect(conn *ec2.EC2, input *ec2.DescribeTransitGatewayConnectsInput) (*ec2.TransitGatewayConnect, error) {
+	output, err := conn.DescribeTransitGatewayConnects(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayAttachmentIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayConnects) == 0 || output.TransitGatewayConnects[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output.TransitGatewayConnects) > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("too many results: wanted 1, got %d", len(output.TransitGatewayConnects)),
+			LastRequest: input,
+		}
+	}
+
+	return output.TransitGatewayConnects[0], nil
+}
+
+// TransitGatewayConnectPeerByID looks up a Transit Gateway Connect peer by ID. Returns a resource.NotFoundError if not found.
+func TransitGatewayConnectPeerByID(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnectPeer, error) {
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{
+		TransitGatewayConnectPeerIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := TransitGatewayConnectPeer(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.TransitGatewayConnectPeerStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.TransitGatewayConnectPeerId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// TransitGatewayConnectPeer looks up a Transit Gateway Connect peer using an ec2.DescribeTransitGatewayConnectPeersInput. Returns a resource.NotFoundError if not found.
+func TransitGatewayConnectPeer(conn *ec2.EC2, input *ec2.DescribeTransitGatewayConnectPeersInput) (*ec2.TransitGatewayConnectPeer, error) {
+	output, err := conn.DescribeTransitGatewayConnectPeers(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayConnectPeerIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayConnectPeers) == 0 || output.TransitGatewayConnectPeers[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output.TransitGatewayConnectPeers) > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("too many results: wanted 1, got %d", len(output.TransitGatewayConnectPeers)),
+			LastRequest: input,
+		}
+	}
+
+	return output.TransitGatewayConnectPeers[0], nil
+}
+
+// TransitGatewayMulticastDomainByID looks up a Transit Gateway multicast domain by ID. Returns a resource.NotFoundError if not found.
+func TransitGatewayMulticastDomainByID(conn *ec2.EC2, id string) (*ec2.TransitGatewayMulticastDomain, error) {
+	input := &ec2.DescribeTransitGatewayMulticastDomainsInput{
+		TransitGatewayMulticastDomainIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := TransitGatewayMulticastDomain(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.TransitGatewayMulticastDomainStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.TransitGatewayMulticastDomainId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// TransitGatewayMulticastDomain looks up a Transit Gateway multicast domain using an ec2.DescribeTransitGatewayMulticastDomainsInput. Returns a resource.NotFoundError if not found.
+func TransitGatewayMulticastDomain(conn *ec2.EC2, input *ec2.DescribeTransitGatewayMulticastDomainsInput) (*ec2.TransitGatewayMulticastDomain, error) {
+	output, err := conn.DescribeTransitGatewayMulticastDomains(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayMulticastDomains) == 0 || output.TransitGatewayMulticastDomains[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output.TransitGatewayMulticastDomains) > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("too many results: wanted 1, got %d", len(output.TransitGatewayMulticastDomains)),
+			LastRequest: input,
+		}
+	}
+
+	return output.TransitGatewayMulticastDomains[0], nil
+}
+
+// TransitGatewayMulticastDomainAssociationByThreePartKey looks up a Transit Gateway multicast domain subnet association. Returns a resource.NotFoundError if not found.
+func TransitGatewayMulticastDomainAssociationByThreePartKey(conn *ec2.EC2, multicastDomainID, attachmentID, subnetID string) (*ec2.TransitGatewayMulticastDomainAssociation, error) {
+	input := &ec2.GetTransitGatewayMulticastDomainAssociationsInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"subnet-id":                     subnetID,
+			"transit-gateway-attachment-id": attachmentID,
+		}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	output, err := TransitGatewayMulticastDomainAssociation(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Subnet == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if state := aws.StringValue(output.Subnet.State); state == ec2.TransitGatewayMulitcastDomainAssociationStateDisassociated {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.TransitGatewayAttachmentId) != attachmentID || aws.StringValue(output.Subnet.SubnetId) != subnetID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// TransitGatewayMulticastDomainAssociation looks up a Transit Gateway multicast domain association using an ec2.GetTransitGatewayMulticastDomainAssociationsInput. Returns a resource.NotFoundError if not found.
+func TransitGatewayMulticastDomainAssociation(conn *ec2.EC2, input *ec2.GetTransitGatewayMulticastDomainAssociationsInput) (*ec2.TransitGatewayMulticastDomainAssociation, error) {
+	output, err := TransitGatewayMulticastDomainAssociations(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output) > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("too many results: wanted 1, got %d", len(output)),
+			LastRequest: input,
+		}
+	}
+
+	return output[0], nil
+}
+
+// TransitGatewayMulticastDomainAssociations looks up Transit Gateway multicast domain associations using an ec2.GetTransitGatewayMulticastDomainAssociationsInput. Returns a resource.NotFoundError if the multicast domain is not found.
+func TransitGatewayMulticastDomainAssociations(conn *ec2.EC2, input *ec2.GetTransitGatewayMulticastDomainAssociationsInput) ([]*ec2.TransitGatewayMulticastDomainAssociation, error) {
+	var output []*ec2.TransitGatewayMulticastDomainAssociation
+
+	err := conn.GetTransitGatewayMulticastDomainAssociationsPages(input, func(page *ec2.GetTransitGatewayMulticastDomainAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MulticastDomainAssociations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// TransitGatewayMulticastGroupMemberByThreePartKey looks up a Transit Gateway multicast group member. Returns a resource.NotFoundError if not found.
+func TransitGatewayMulticastGroupMemberByThreePartKey(conn *ec2.EC2, multicastDomainID, groupIPAddress, eniID string) (*ec2.TransitGatewayMulticastGroup, error) {
+	input := &ec2.SearchTransitGatewayMulticastGroupsInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"group-ip-address": groupIPAddress,
+			"is-group-member":  "true",
+			"is-group-source":  "false",
+		}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	return transitGatewayMulticastGroupByENI(conn, input, eniID)
+}
+
+// TransitGatewayMulticastGroupSourceByThreePartKey looks up a Transit Gateway multicast group source. Returns a resource.NotFoundError if not found.
+func TransitGatewayMulticastGroupSourceByThreePartKey(conn *ec2.EC2, multicastDomainID, groupIPAddress, eniID string) (*ec2.TransitGatewayMulticastGroup, error) {
+	input := &ec2.SearchTransitGatewayMulticastGroupsInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"group-ip-address": groupIPAddress,
+			"is-group-member":  "false",
+			"is-group-source":  "true",
+		}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	return transitGatewayMulticastGroupByENI(conn, input, eniID)
+}
+
+func transitGatewayMulticastGroupByENI(conn *ec2.EC2, input *ec2.SearchTransitGatewayMulticastGroupsInput, eniID string) (*ec2.TransitGatewayMulticastGroup, error) {
+	output, err := TransitGatewayMulticastGroups(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.StringValue(v.NetworkInterfaceId) == eniID {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "empty result",
+		LastRequest: input,
+	}
+}
+
+// TransitGatewayMulticastGroups looks up Transit Gateway multicast groups using an ec2.SearchTransitGatewayMulticastGroupsInput. Returns a resource.NotFoundError if the multicast domain is not found.
+func TransitGatewayMulticastGroups(conn *ec2.EC2, input *ec2.SearchTransitGatewayMulticastGroupsInput) ([]*ec2.TransitGatewayMulticastGroup, error) {
+	var output []*ec2.TransitGatewayMulticastGroup
+
+	err := conn.SearchTransitGatewayMulticastGroupsPages(input, func(page *ec2.SearchTransitGatewayMulticastGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MulticastGroups {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func TransitGatewayPrefixListReference(conn *ec2.EC2, transitGatewayRouteTableID string, prefixListID string) (*ec2.TransitGatewayPrefixListReference, error) {
 	filters := map[string]string{
 		"prefix-list-id": prefixListID,
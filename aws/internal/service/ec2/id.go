@@ -71,6 +71,44 @@ func ClientVpnRouteParseID(id string) (string, string, string, error) {
 			"target-subnet-id"+clientVpnRouteIDSeparator+"destination-cidr-block", id)
 }
 
+const ipamPoolCidrSeparator = "_"
+
+func IpamPoolCidrCreateID(cidr, poolID string) string {
+	parts := []string{cidr, poolID}
+	id := strings.Join(parts, ipamPoolCidrSeparator)
+
+	return id
+}
+
+func IpamPoolCidrParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ipamPoolCidrSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cidr%[2]sipam-pool-id", id, ipamPoolCidrSeparator)
+}
+
+const ipamPoolCidrAllocationSeparator = "_"
+
+func IpamPoolCidrAllocationCreateID(allocationID, poolID string) string {
+	parts := []string{allocationID, poolID}
+	id := strings.Join(parts, ipamPoolCidrAllocationSeparator)
+
+	return id
+}
+
+func IpamPoolCidrAllocationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ipamPoolCidrAllocationSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ipam-pool-allocation-id%[2]sipam-pool-id", id, ipamPoolCidrAllocationSeparator)
+}
+
 // RouteCreateID returns a route resource ID.
 func RouteCreateID(routeTableID, destination string) string {
 	return fmt.Sprintf("r-%s%d", routeTableID, hashcode.String(destination))
@@ -95,6 +133,44 @@ func TransitGatewayPrefixListReferenceParseID(id string) (string, string, error)
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected transit-gateway-route-table-id%[2]sprefix-list-id", id, transitGatewayPrefixListReferenceSeparator)
 }
 
+const transitGatewayMulticastDomainAssociationSeparator = "_"
+
+func TransitGatewayMulticastDomainAssociationCreateID(multicastDomainID, attachmentID, subnetID string) string {
+	parts := []string{multicastDomainID, attachmentID, subnetID}
+	id := strings.Join(parts, transitGatewayMulticastDomainAssociationSeparator)
+
+	return id
+}
+
+func TransitGatewayMulticastDomainAssociationParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastDomainAssociationSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected multicast-domain-id%[2]sattachment-id%[2]ssubnet-id", id, transitGatewayMulticastDomainAssociationSeparator)
+}
+
+const transitGatewayMulticastGroupSeparator = "_"
+
+func TransitGatewayMulticastGroupCreateID(multicastDomainID, groupIPAddress, eniID string) string {
+	parts := []string{multicastDomainID, groupIPAddress, eniID}
+	id := strings.Join(parts, transitGatewayMulticastGroupSeparator)
+
+	return id
+}
+
+func TransitGatewayMulticastGroupParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastGroupSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected multicast-domain-id%[2]sgroup-ip-address%[2]snetwork-interface-id", id, transitGatewayMulticastGroupSeparator)
+}
+
 func VpcEndpointRouteTableAssociationCreateID(vpcEndpointID, routeTableID string) string {
 	return fmt.Sprintf("a-%s%d", vpcEndpointID, hashcode.String(routeTableID))
 }
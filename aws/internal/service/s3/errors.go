@@ -4,7 +4,11 @@ package s3
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3/#pkg-constants
 
 const (
-	ErrCodeNoSuchConfiguration                  = "NoSuchConfiguration"
-	ErrCodeNoSuchPublicAccessBlockConfiguration = "NoSuchPublicAccessBlockConfiguration"
-	ErrCodeNoSuchTagSet                         = "NoSuchTagSet"
+	ErrCodeNoSuchConfiguration                            = "NoSuchConfiguration"
+	ErrCodeNoSuchCORSConfiguration                        = "NoSuchCORSConfiguration"
+	ErrCodeNoSuchLifecycleConfiguration                   = "NoSuchLifecycleConfiguration"
+	ErrCodeNoSuchPublicAccessBlockConfiguration           = "NoSuchPublicAccessBlockConfiguration"
+	ErrCodeNoSuchTagSet                                   = "NoSuchTagSet"
+	ErrCodeNoSuchWebsiteConfiguration                     = "NoSuchWebsiteConfiguration"
+	ErrCodeServerSideEncryptionConfigurationNotFoundError = "ServerSideEncryptionConfigurationNotFoundError"
 )
@@ -0,0 +1,42 @@
+package s3
+
+import (
+	"regexp"
+	"strings"
+)
+
+// resourceIDSeparator separates a bucket-scoped resource identifier from its expected bucket owner,
+// e.g. my-bucket,123456789012. Bucket names cannot contain commas.
+const resourceIDSeparator = ","
+
+var expectedBucketOwnerRegexp = regexp.MustCompile(`^\d{12}$`)
+
+// CreateResourceID returns the identifier for a bucket-scoped resource,
+// suffixed with the expected bucket owner account ID if one is specified.
+func CreateResourceID(id, expectedBucketOwner string) string {
+	if expectedBucketOwner == "" {
+		return id
+	}
+
+	parts := []string{id, expectedBucketOwner}
+	id = strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+// ParseResourceID returns the bucket-scoped resource identifier and expected bucket owner account ID
+// from an identifier created by CreateResourceID. Identifiers without a valid account ID suffix
+// are returned unchanged, with an empty expected bucket owner.
+func ParseResourceID(id string) (string, string) {
+	i := strings.LastIndex(id, resourceIDSeparator)
+
+	if i < 0 {
+		return id, ""
+	}
+
+	if expectedBucketOwner := id[i+len(resourceIDSeparator):]; expectedBucketOwnerRegexp.MatchString(expectedBucketOwner) && i > 0 {
+		return id[:i], expectedBucketOwner
+	}
+
+	return id, ""
+}
@@ -0,0 +1,68 @@
+package s3_test
+
+import (
+	"testing"
+
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+)
+
+func TestParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName                    string
+		InputID                     string
+		ExpectedID                  string
+		ExpectedExpectedBucketOwner string
+	}{
+		{
+			TestName:   "empty ID",
+			InputID:    "",
+			ExpectedID: "",
+		},
+		{
+			TestName:   "bucket",
+			InputID:    tfs3.CreateResourceID("example", ""),
+			ExpectedID: "example",
+		},
+		{
+			TestName:                    "bucket and expected bucket owner",
+			InputID:                     tfs3.CreateResourceID("example", "123456789012"),
+			ExpectedID:                  "example",
+			ExpectedExpectedBucketOwner: "123456789012",
+		},
+		{
+			TestName:                    "bucket configuration and expected bucket owner",
+			InputID:                     tfs3.CreateResourceID("example:configuration", "123456789012"),
+			ExpectedID:                  "example:configuration",
+			ExpectedExpectedBucketOwner: "123456789012",
+		},
+		{
+			TestName:   "invalid expected bucket owner",
+			InputID:    "example,configuration",
+			ExpectedID: "example,configuration",
+		},
+		{
+			TestName:   "empty expected bucket owner",
+			InputID:    "example,",
+			ExpectedID: "example,",
+		},
+		{
+			TestName:   "empty bucket",
+			InputID:    ",123456789012",
+			ExpectedID: ",123456789012",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotID, gotExpectedBucketOwner := tfs3.ParseResourceID(testCase.InputID)
+
+			if gotID != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", gotID, testCase.ExpectedID)
+			}
+
+			if gotExpectedBucketOwner != testCase.ExpectedExpectedBucketOwner {
+				t.Errorf("got expected bucket owner %s, expected %s", gotExpectedBucketOwner, testCase.ExpectedExpectedBucketOwner)
+			}
+		})
+	}
+}
@@ -3,6 +3,9 @@ package finder
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
 )
 
 func PublicAccessBlockConfiguration(conn *s3control.S3Control, accountID string) (*s3control.PublicAccessBlockConfiguration, error) {
@@ -22,3 +25,232 @@ func PublicAccessBlockConfiguration(conn *s3control.S3Control, accountID string)
 
 	return output.PublicAccessBlockConfiguration, nil
 }
+
+func AccessPointPolicyAndStatusByAccountIDAndName(conn *s3control.S3Control, accountID string, name string) (string, *s3control.PolicyStatus, error) {
+	input1 := &s3control.GetAccessPointPolicyInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output1, err := conn.GetAccessPointPolicy(input1)
+
+	if tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPoint) || tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPointPolicy) {
+		return "", nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input1,
+		}
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if output1 == nil {
+		return "", nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input1,
+		}
+	}
+
+	policy := aws.StringValue(output1.Policy)
+
+	if policy == "" {
+		return "", nil, &resource.NotFoundError{
+			Message:     "Empty policy",
+			LastRequest: input1,
+		}
+	}
+
+	input2 := &s3control.GetAccessPointPolicyStatusInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output2, err := conn.GetAccessPointPolicyStatus(input2)
+
+	if tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPointPolicy) {
+		return policy, &s3control.PolicyStatus{}, nil
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if output2 == nil || output2.PolicyStatus == nil {
+		return "", nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input2,
+		}
+	}
+
+	return policy, output2.PolicyStatus, nil
+}
+
+func ObjectLambdaAccessPointByAccountIDAndName(conn *s3control.S3Control, accountID string, name string) (*s3control.ObjectLambdaConfiguration, error) {
+	input := &s3control.GetAccessPointConfigurationForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetAccessPointConfigurationForObjectLambda(input)
+
+	if tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPoint) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Configuration, nil
+}
+
+func ObjectLambdaAccessPointPolicyAndStatusByAccountIDAndName(conn *s3control.S3Control, accountID string, name string) (string, *s3control.PolicyStatus, error) {
+	input1 := &s3control.GetAccessPointPolicyForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output1, err := conn.GetAccessPointPolicyForObjectLambda(input1)
+
+	if tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPoint) || tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPointPolicy) {
+		return "", nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input1,
+		}
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if output1 == nil {
+		return "", nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input1,
+		}
+	}
+
+	policy := aws.StringValue(output1.Policy)
+
+	if policy == "" {
+		return "", nil, &resource.NotFoundError{
+			Message:     "Empty policy",
+			LastRequest: input1,
+		}
+	}
+
+	input2 := &s3control.GetAccessPointPolicyStatusForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output2, err := conn.GetAccessPointPolicyStatusForObjectLambda(input2)
+
+	if tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPointPolicy) {
+		return policy, &s3control.PolicyStatus{}, nil
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if output2 == nil || output2.PolicyStatus == nil {
+		return "", nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input2,
+		}
+	}
+
+	return policy, output2.PolicyStatus, nil
+}
+
+func MultiRegionAccessPointByAccountIDAndName(conn *s3control.S3Control, accountID string, name string) (*s3control.MultiRegionAccessPointReport, error) {
+	input := &s3control.GetMultiRegionAccessPointInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetMultiRegionAccessPoint(input)
+
+	if tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchMultiRegionAccessPoint) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessPoint == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.AccessPoint, nil
+}
+
+func MultiRegionAccessPointOperationByAccountIDAndTokenARN(conn *s3control.S3Control, accountID string, requestTokenARN string) (*s3control.AsyncOperation, error) {
+	input := &s3control.DescribeMultiRegionAccessPointOperationInput{
+		AccountId:       aws.String(accountID),
+		RequestTokenARN: aws.String(requestTokenARN),
+	}
+
+	output, err := conn.DescribeMultiRegionAccessPointOperation(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AsyncOperation == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.AsyncOperation, nil
+}
+
+func MultiRegionAccessPointPolicyDocumentByAccountIDAndName(conn *s3control.S3Control, accountID string, name string) (*s3control.MultiRegionAccessPointPolicyDocument, error) {
+	input := &s3control.GetMultiRegionAccessPointPolicyInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetMultiRegionAccessPointPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchMultiRegionAccessPoint) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Policy, nil
+}
@@ -0,0 +1,44 @@
+package s3control
+
+import (
+	"fmt"
+	"strings"
+)
+
+const objectLambdaAccessPointResourceIDSeparator = ":"
+
+func ObjectLambdaAccessPointCreateResourceID(accountID, accessPointName string) string {
+	parts := []string{accountID, accessPointName}
+	id := strings.Join(parts, objectLambdaAccessPointResourceIDSeparator)
+
+	return id
+}
+
+func ObjectLambdaAccessPointParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, objectLambdaAccessPointResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]saccess-point-name", id, objectLambdaAccessPointResourceIDSeparator)
+}
+
+const multiRegionAccessPointResourceIDSeparator = ":"
+
+func MultiRegionAccessPointCreateResourceID(accountID, accessPointName string) string {
+	parts := []string{accountID, accessPointName}
+	id := strings.Join(parts, multiRegionAccessPointResourceIDSeparator)
+
+	return id
+}
+
+func MultiRegionAccessPointParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, multiRegionAccessPointResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]saccess-point-name", id, multiRegionAccessPointResourceIDSeparator)
+}
@@ -0,0 +1,145 @@
+package s3control_test
+
+import (
+	"testing"
+
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+)
+
+func TestObjectLambdaAccessPointParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single part",
+			InputID:       "123456789012",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "two parts",
+			InputID:       tfs3control.ObjectLambdaAccessPointCreateResourceID("123456789012", "example"),
+			ExpectedPart0: "123456789012",
+			ExpectedPart1: "example",
+		},
+		{
+			TestName:      "empty both parts",
+			InputID:       ":",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty first part",
+			InputID:       ":example",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty second part",
+			InputID:       "123456789012:",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "three parts",
+			InputID:       "123456789012:example:example",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, err := tfs3control.ObjectLambdaAccessPointParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+		})
+	}
+}
+
+func TestMultiRegionAccessPointParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single part",
+			InputID:       "123456789012",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "two parts",
+			InputID:       tfs3control.MultiRegionAccessPointCreateResourceID("123456789012", "example"),
+			ExpectedPart0: "123456789012",
+			ExpectedPart1: "example",
+		},
+		{
+			TestName:      "empty both parts",
+			InputID:       ":",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty first part",
+			InputID:       ":example",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty second part",
+			InputID:       "123456789012:",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "three parts",
+			InputID:       "123456789012:example:example",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, err := tfs3control.MultiRegionAccessPointParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+		})
+	}
+}
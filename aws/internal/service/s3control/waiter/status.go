@@ -1,6 +1,7 @@
 package waiter
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -76,3 +77,26 @@ func PublicAccessBlockConfigurationRestrictPublicBuckets(conn *s3control.S3Contr
 		return publicAccessBlockConfiguration, strconv.FormatBool(aws.BoolValue(publicAccessBlockConfiguration.RestrictPublicBuckets)), nil
 	}
 }
+
+// MultiRegionAccessPointRequestStatus fetches the asynchronous Multi-Region Access Point operation and its RequestStatus
+func MultiRegionAccessPointRequestStatus(conn *s3control.S3Control, accountID, requestTokenARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.MultiRegionAccessPointOperationByAccountIDAndTokenARN(conn, accountID, requestTokenARN)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.RequestStatus)
+
+		if status == RequestStatusFailed {
+			if v := output.ResponseDetails; v != nil && v.ErrorDetails != nil {
+				return output, status, fmt.Errorf("%s: %s", aws.StringValue(v.ErrorDetails.Code), aws.StringValue(v.ErrorDetails.Message))
+			}
+
+			return output, status, fmt.Errorf("%s", status)
+		}
+
+		return output, status, nil
+	}
+}
@@ -19,6 +19,20 @@ const (
 	PropagationTimeout = 1 * time.Minute
 )
 
+// Multi-Region Access Point asynchronous request statuses, missing from AWS Go SDK
+const (
+	RequestStatusFailed    = "FAILED"
+	RequestStatusSucceeded = "SUCCEEDED"
+)
+
+const (
+	// Minimum amount of time to wait between Multi-Region Access Point request status polls
+	MultiRegionAccessPointRequestSucceededMinTimeout = 5 * time.Second
+
+	// Amount of time to wait before polling Multi-Region Access Point request status
+	MultiRegionAccessPointRequestSucceededDelay = 15 * time.Second
+)
+
 func PublicAccessBlockConfigurationBlockPublicAclsUpdated(conn *s3control.S3Control, accountID string, expectedValue bool) (*s3control.PublicAccessBlockConfiguration, error) {
 	stateConf := &resource.StateChangeConf{
 		Target:                    []string{strconv.FormatBool(expectedValue)},
@@ -90,3 +104,22 @@ func PublicAccessBlockConfigurationRestrictPublicBucketsUpdated(conn *s3control.
 
 	return nil, err
 }
+
+// MultiRegionAccessPointRequestSucceeded waits for an asynchronous Multi-Region Access Point request to return Succeeded
+func MultiRegionAccessPointRequestSucceeded(conn *s3control.S3Control, accountID, requestTokenARN string, timeout time.Duration) (*s3control.AsyncOperation, error) {
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{RequestStatusSucceeded},
+		Refresh:    MultiRegionAccessPointRequestStatus(conn, accountID, requestTokenARN),
+		Timeout:    timeout,
+		MinTimeout: MultiRegionAccessPointRequestSucceededMinTimeout,
+		Delay:      MultiRegionAccessPointRequestSucceededDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.AsyncOperation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,79 @@
+package sts
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+)
+
+// WebIdentityToken is a stscreds.TokenFetcher that returns the specified web identity token.
+type WebIdentityToken string
+
+// FetchToken implements stscreds.TokenFetcher.
+func (t WebIdentityToken) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(t), nil
+}
+
+// WebIdentityTokenFile is a stscreds.TokenFetcher that reads a web identity token from the specified file.
+// The file is read on every call so that rotated tokens are picked up when credentials are refreshed,
+// and surrounding whitespace such as a trailing newline is removed.
+type WebIdentityTokenFile string
+
+// FetchToken implements stscreds.TokenFetcher.
+func (f WebIdentityTokenFile) FetchToken(ctx credentials.Context) ([]byte, error) {
+	b, err := stscreds.FetchTokenPath(f).FetchToken(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSpace(b), nil
+}
+
+// ValidateWebIdentityTokenFile returns an error if the specified web identity token file
+// does not exist, is a directory or is empty.
+func ValidateWebIdentityTokenFile(filename string) error {
+	info, err := os.Stat(filename)
+
+	if err != nil {
+		return fmt.Errorf("error reading web identity token file (%s): %w", filename, err)
+	}
+
+	if info.IsDir() {
+		return fmt.Errorf("web identity token file (%s) is a directory", filename)
+	}
+
+	if info.Size() == 0 {
+		return fmt.Errorf("web identity token file (%s) is empty", filename)
+	}
+
+	return nil
+}
+
+// WebIdentityPolicyClient returns an STS client that adds the specified session policy
+// to AssumeRoleWithWebIdentity requests, which stscreds.WebIdentityRoleProvider does not support.
+func WebIdentityPolicyClient(conn stsiface.STSAPI, policy string) stsiface.STSAPI {
+	return &webIdentityPolicyClient{
+		STSAPI: conn,
+		policy: policy,
+	}
+}
+
+type webIdentityPolicyClient struct {
+	stsiface.STSAPI
+
+	policy string
+}
+
+func (c *webIdentityPolicyClient) AssumeRoleWithWebIdentityRequest(input *sts.AssumeRoleWithWebIdentityInput) (*request.Request, *sts.AssumeRoleWithWebIdentityOutput) {
+	input.Policy = aws.String(c.policy)
+
+	return c.STSAPI.AssumeRoleWithWebIdentityRequest(input)
+}
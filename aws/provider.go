@@ -1,13 +1,18 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/mutexkv"
+	tfretry "github.com/terraform-providers/terraform-provider-aws/aws/internal/retry"
 )
 
 // Provider returns a *schema.Provider.
@@ -41,11 +46,30 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
-			"shared_credentials_file": {
-				Type:        schema.TypeString,
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
+			"shared_config_files": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Default:     "",
-				Description: descriptions["shared_credentials_file"],
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["shared_config_files"],
+			},
+
+			"shared_credentials_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				Description:   descriptions["shared_credentials_file"],
+				Deprecated:    "Use shared_credentials_files instead.",
+				ConflictsWith: []string{"shared_credentials_files"},
+			},
+
+			"shared_credentials_files": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   descriptions["shared_credentials_files"],
+				ConflictsWith: []string{"shared_credentials_file"},
 			},
 
 			"token": {
@@ -73,6 +97,14 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_RETRY_MODE", tfretry.ModeStandard),
+				Description:  descriptions["retry_mode"],
+				ValidateFunc: validation.StringInSlice(tfretry.Modes(), false),
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -96,6 +128,11 @@ func Provider() *schema.Provider {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"propagate_to_launched_instances": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether to propagate default tags to instances and volumes launched by Auto Scaling Groups and Launch Templates",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -133,6 +170,37 @@ func Provider() *schema.Provider {
 				},
 			},
 
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to require resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      keyvaluetags.RequiredEnforcementError,
+							ValidateFunc: validation.StringInSlice(keyvaluetags.RequiredEnforcements(), false),
+							Description:  "Whether resources violating the required tags fail with an error or only log a warning.",
+						},
+						"key_patterns": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Regular expressions that the values of resource tags with matching keys must match.",
+						},
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys to require across all resources.",
+						},
+					},
+				},
+			},
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -140,6 +208,33 @@ func Provider() *schema.Provider {
 				Description: descriptions["insecure"],
 			},
 
+			"http_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["http_proxy"],
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+
+			"https_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["https_proxy"],
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["no_proxy"],
+			},
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -181,6 +276,20 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_fips_endpoint"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -222,7 +331,7 @@ func Provider() *schema.Provider {
 			"aws_cloudtrail_service_account":                 dataSourceAwsCloudTrailServiceAccount(),
 			"aws_cloudwatch_event_connection":                dataSourceAwsCloudwatchEventConnection(),
 			"aws_cloudwatch_event_source":                    dataSourceAwsCloudWatchEventSource(),
-			"aws_cloudwatch_log_group":                       dataSourceAwsCloudwatchLogGroup(),
+			"aws_cloudwatch_log_group":                       regionalDataSource(dataSourceAwsCloudwatchLogGroup()),
 			"aws_codeartifact_authorization_token":           dataSourceAwsCodeArtifactAuthorizationToken(),
 			"aws_codeartifact_repository_endpoint":           dataSourceAwsCodeArtifactRepositoryEndpoint(),
 			"aws_cognito_user_pools":                         dataSourceAwsCognitoUserPools(),
@@ -386,6 +495,7 @@ func Provider() *schema.Provider {
 			"aws_route53_zone":                               dataSourceAwsRoute53Zone(),
 			"aws_s3_bucket":                                  dataSourceAwsS3Bucket(),
 			"aws_s3_bucket_object":                           dataSourceAwsS3BucketObject(),
+			"aws_s3_bucket_object_versions":                  dataSourceAwsS3BucketObjectVersions(),
 			"aws_s3_bucket_objects":                          dataSourceAwsS3BucketObjects(),
 			"aws_sagemaker_prebuilt_ecr_image":               dataSourceAwsSageMakerPrebuiltECRImage(),
 			"aws_secretsmanager_secret":                      dataSourceAwsSecretsManagerSecret(),
@@ -403,10 +513,10 @@ func Provider() *schema.Provider {
 			"aws_sfn_state_machine":                          dataSourceAwsSfnStateMachine(),
 			"aws_signer_signing_job":                         dataSourceAwsSignerSigningJob(),
 			"aws_signer_signing_profile":                     dataSourceAwsSignerSigningProfile(),
-			"aws_sns_topic":                                  dataSourceAwsSnsTopic(),
-			"aws_sqs_queue":                                  dataSourceAwsSqsQueue(),
+			"aws_sns_topic":                                  regionalDataSource(dataSourceAwsSnsTopic()),
+			"aws_sqs_queue":                                  regionalDataSource(dataSourceAwsSqsQueue()),
 			"aws_ssm_document":                               dataSourceAwsSsmDocument(),
-			"aws_ssm_parameter":                              dataSourceAwsSsmParameter(),
+			"aws_ssm_parameter":                              regionalDataSource(dataSourceAwsSsmParameter()),
 			"aws_ssm_patch_baseline":                         dataSourceAwsSsmPatchBaseline(),
 			"aws_ssoadmin_instances":                         dataSourceAwsSsoAdminInstances(),
 			"aws_ssoadmin_permission_set":                    dataSourceAwsSsoAdminPermissionSet(),
@@ -421,8 +531,11 @@ func Provider() *schema.Provider {
 			"aws_vpc_dhcp_options":                           dataSourceAwsVpcDhcpOptions(),
 			"aws_vpc_endpoint":                               dataSourceAwsVpcEndpoint(),
 			"aws_vpc_endpoint_service":                       dataSourceAwsVpcEndpointService(),
+			"aws_vpc_ipam_preview_next_cidr":                 dataSourceAwsVpcIpamPreviewNextCidr(),
 			"aws_vpc_peering_connection":                     dataSourceAwsVpcPeeringConnection(),
 			"aws_vpc_peering_connections":                    dataSourceAwsVpcPeeringConnections(),
+			"aws_vpc_security_group_rule":                    dataSourceAwsVpcSecurityGroupRule(),
+			"aws_vpc_security_group_rules":                   dataSourceAwsVpcSecurityGroupRules(),
 			"aws_vpn_gateway":                                dataSourceAwsVpnGateway(),
 			"aws_waf_ipset":                                  dataSourceAwsWafIpSet(),
 			"aws_waf_rule":                                   dataSourceAwsWafRule(),
@@ -571,7 +684,7 @@ func Provider() *schema.Provider {
 			"aws_cloudwatch_event_api_destination":                    resourceAwsCloudWatchEventApiDestination(),
 			"aws_cloudwatch_log_destination":                          resourceAwsCloudWatchLogDestination(),
 			"aws_cloudwatch_log_destination_policy":                   resourceAwsCloudWatchLogDestinationPolicy(),
-			"aws_cloudwatch_log_group":                                resourceAwsCloudWatchLogGroup(),
+			"aws_cloudwatch_log_group":                                regionalResource(resourceAwsCloudWatchLogGroup()),
 			"aws_cloudwatch_log_metric_filter":                        resourceAwsCloudWatchLogMetricFilter(),
 			"aws_cloudwatch_log_resource_policy":                      resourceAwsCloudWatchLogResourcePolicy(),
 			"aws_cloudwatch_log_stream":                               resourceAwsCloudWatchLogStream(),
@@ -705,6 +818,12 @@ func Provider() *schema.Provider {
 			"aws_ec2_traffic_mirror_target":                           resourceAwsEc2TrafficMirrorTarget(),
 			"aws_ec2_traffic_mirror_session":                          resourceAwsEc2TrafficMirrorSession(),
 			"aws_ec2_transit_gateway":                                 resourceAwsEc2TransitGateway(),
+			"aws_ec2_transit_gateway_connect":                         resourceAwsEc2TransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                    resourceAwsEc2TransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_multicast_domain":                resourceAwsEc2TransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_multicast_domain_association":    resourceAwsEc2TransitGatewayMulticastDomainAssociation(),
+			"aws_ec2_transit_gateway_multicast_group_member":          resourceAwsEc2TransitGatewayMulticastGroupMember(),
+			"aws_ec2_transit_gateway_multicast_group_source":          resourceAwsEc2TransitGatewayMulticastGroupSource(),
 			"aws_ec2_transit_gateway_peering_attachment":              resourceAwsEc2TransitGatewayPeeringAttachment(),
 			"aws_ec2_transit_gateway_peering_attachment_accepter":     resourceAwsEc2TransitGatewayPeeringAttachmentAccepter(),
 			"aws_ec2_transit_gateway_prefix_list_reference":           resourceAwsEc2TransitGatewayPrefixListReference(),
@@ -1032,18 +1151,32 @@ func Provider() *schema.Provider {
 			"aws_s3_access_point":                                     resourceAwsS3AccessPoint(),
 			"aws_s3_account_public_access_block":                      resourceAwsS3AccountPublicAccessBlock(),
 			"aws_s3_bucket":                                           resourceAwsS3Bucket(),
+			"aws_s3_bucket_acl":                                       resourceAwsS3BucketAcl(),
 			"aws_s3_bucket_analytics_configuration":                   resourceAwsS3BucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                        resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_intelligent_tiering_configuration":         resourceAwsS3BucketIntelligentTieringConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":                   resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                                   resourceAwsS3BucketLogging(),
+			"aws_s3_bucket_server_side_encryption_configuration":      resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                                resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                     resourceAwsS3BucketWebsiteConfiguration(),
 			"aws_s3_bucket_policy":                                    resourceAwsS3BucketPolicy(),
 			"aws_s3_bucket_public_access_block":                       resourceAwsS3BucketPublicAccessBlock(),
 			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
+			"aws_s3_bucket_objects_sync":                              resourceAwsS3BucketObjectsSync(),
 			"aws_s3_bucket_ownership_controls":                        resourceAwsS3BucketOwnershipControls(),
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
 			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
 			"aws_s3_object_copy":                                      resourceAwsS3ObjectCopy(),
+			"aws_s3control_access_point_policy":                       resourceAwsS3ControlAccessPointPolicy(),
 			"aws_s3control_bucket":                                    resourceAwsS3ControlBucket(),
 			"aws_s3control_bucket_policy":                             resourceAwsS3ControlBucketPolicy(),
 			"aws_s3control_bucket_lifecycle_configuration":            resourceAwsS3ControlBucketLifecycleConfiguration(),
+			"aws_s3control_multi_region_access_point":                 resourceAwsS3ControlMultiRegionAccessPoint(),
+			"aws_s3control_multi_region_access_point_policy":          resourceAwsS3ControlMultiRegionAccessPointPolicy(),
+			"aws_s3control_object_lambda_access_point":                resourceAwsS3ControlObjectLambdaAccessPoint(),
+			"aws_s3control_object_lambda_access_point_policy":         resourceAwsS3ControlObjectLambdaAccessPointPolicy(),
 			"aws_s3outposts_endpoint":                                 resourceAwsS3OutpostsEndpoint(),
 			"aws_security_group":                                      resourceAwsSecurityGroup(),
 			"aws_network_interface_sg_attachment":                     resourceAwsNetworkInterfaceSGAttachment(),
@@ -1090,7 +1223,7 @@ func Provider() *schema.Provider {
 			"aws_ssm_maintenance_window_task":                         resourceAwsSsmMaintenanceWindowTask(),
 			"aws_ssm_patch_baseline":                                  resourceAwsSsmPatchBaseline(),
 			"aws_ssm_patch_group":                                     resourceAwsSsmPatchGroup(),
-			"aws_ssm_parameter":                                       resourceAwsSsmParameter(),
+			"aws_ssm_parameter":                                       regionalResource(resourceAwsSsmParameter()),
 			"aws_ssm_resource_data_sync":                              resourceAwsSsmResourceDataSync(),
 			"aws_ssoadmin_account_assignment":                         resourceAwsSsoAdminAccountAssignment(),
 			"aws_ssoadmin_managed_policy_attachment":                  resourceAwsSsoAdminManagedPolicyAttachment(),
@@ -1108,12 +1241,12 @@ func Provider() *schema.Provider {
 			"aws_spot_datafeed_subscription":                          resourceAwsSpotDataFeedSubscription(),
 			"aws_spot_instance_request":                               resourceAwsSpotInstanceRequest(),
 			"aws_spot_fleet_request":                                  resourceAwsSpotFleetRequest(),
-			"aws_sqs_queue":                                           resourceAwsSqsQueue(),
+			"aws_sqs_queue":                                           regionalResource(resourceAwsSqsQueue()),
 			"aws_sqs_queue_policy":                                    resourceAwsSqsQueuePolicy(),
 			"aws_snapshot_create_volume_permission":                   resourceAwsSnapshotCreateVolumePermission(),
 			"aws_sns_platform_application":                            resourceAwsSnsPlatformApplication(),
 			"aws_sns_sms_preferences":                                 resourceAwsSnsSmsPreferences(),
-			"aws_sns_topic":                                           resourceAwsSnsTopic(),
+			"aws_sns_topic":                                           regionalResource(resourceAwsSnsTopic()),
 			"aws_sns_topic_policy":                                    resourceAwsSnsTopicPolicy(),
 			"aws_sns_topic_subscription":                              resourceAwsSnsTopicSubscription(),
 			"aws_sfn_activity":                                        resourceAwsSfnActivity(),
@@ -1142,7 +1275,14 @@ func Provider() *schema.Provider {
 			"aws_vpc_endpoint_subnet_association":                     resourceAwsVpcEndpointSubnetAssociation(),
 			"aws_vpc_endpoint_service":                                resourceAwsVpcEndpointService(),
 			"aws_vpc_endpoint_service_allowed_principal":              resourceAwsVpcEndpointServiceAllowedPrincipal(),
+			"aws_vpc_ipam":                                            resourceAwsVpcIpam(),
+			"aws_vpc_ipam_pool":                                       resourceAwsVpcIpamPool(),
+			"aws_vpc_ipam_pool_cidr":                                  resourceAwsVpcIpamPoolCidr(),
+			"aws_vpc_ipam_pool_cidr_allocation":                       resourceAwsVpcIpamPoolCidrAllocation(),
+			"aws_vpc_ipam_scope":                                      resourceAwsVpcIpamScope(),
 			"aws_vpc_ipv4_cidr_block_association":                     resourceAwsVpcIpv4CidrBlockAssociation(),
+			"aws_vpc_security_group_egress_rule":                      resourceAwsVpcSecurityGroupEgressRule(),
+			"aws_vpc_security_group_ingress_rule":                     resourceAwsVpcSecurityGroupIngressRule(),
 			"aws_vpn_connection":                                      resourceAwsVpnConnection(),
 			"aws_vpn_connection_route":                                resourceAwsVpnConnectionRoute(),
 			"aws_vpn_gateway":                                         resourceAwsVpnGateway(),
@@ -1259,6 +1399,12 @@ func init() {
 		"shared_credentials_file": "The path to the shared credentials file. If not set\n" +
 			"this defaults to ~/.aws/credentials.",
 
+		"shared_config_files": "List of paths to shared config files. If not set, the\n" +
+			"default shared config file ~/.aws/config is used.",
+
+		"shared_credentials_files": "List of paths to shared credentials files. If not set,\n" +
+			"the default shared credentials file ~/.aws/credentials is used.",
+
 		"token": "session token. A session token is only required if you are\n" +
 			"using temporary security credentials.",
 
@@ -1266,11 +1412,27 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"retry_mode": "Specifies how retries are attempted. Valid values are `standard` and\n" +
+			"`adaptive`. The `adaptive` mode additionally rate limits requests to a\n" +
+			"service once it starts throttling.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
+		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API over HTTP.\n" +
+			"Overrides the HTTP_PROXY environment variable.",
+
+		"https_proxy": "The address of an HTTP proxy to use when accessing the AWS API over HTTPS.\n" +
+			"Overrides the HTTPS_PROXY environment variable.",
+
+		"no_proxy": "Comma-separated list of hosts that should not be accessed through a proxy.\n" +
+			"Overrides the NO_PROXY environment variable.",
+
+		"custom_ca_bundle": "File containing custom root and intermediary certificates in PEM format.\n" +
+			"Can also be configured using the AWS_CA_BUNDLE environment variable.",
+
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
 
@@ -1290,6 +1452,12 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability. Endpoints configured\n" +
+			"in the `endpoints` block are not changed.",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS capability. Endpoints configured\n" +
+			"in the `endpoints` block are not changed.",
 	}
 
 	endpointServiceNames = []string{
@@ -1465,14 +1633,21 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
+		RetryMode:               d.Get("retry_mode").(string),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
+		HTTPProxy:               d.Get("http_proxy").(string),
+		HTTPSProxy:              d.Get("https_proxy").(string),
+		NoProxy:                 d.Get("no_proxy").(string),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
+		UseDualStackEndpoint:    d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:         d.Get("use_fips_endpoint").(bool),
 		terraformVersion:        terraformVersion,
 	}
 
@@ -1540,6 +1715,27 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
+	if v, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.AssumeRoleWithWebIdentity = expandProviderAssumeRoleWithWebIdentity(v[0].(map[string]interface{}))
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentity.RoleARN, config.AssumeRoleWithWebIdentity.SessionName)
+	}
+
+	requiredTagsConfig, err := expandProviderRequiredTags(d.Get("required_tags").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if ignored := requiredTagsConfig.Ignored(config.IgnoreTagsConfig); len(ignored) > 0 {
+		keys := ignored.Keys()
+		sort.Strings(keys)
+
+		return nil, fmt.Errorf("required_tags keys (%s) are removed by the ignore_tags configuration and can never be satisfied", strings.Join(keys, ", "))
+	}
+
+	config.RequiredTagsConfig = requiredTagsConfig
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1549,6 +1745,22 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	if v, ok := d.GetOk("shared_config_files"); ok {
+		for _, filenameRaw := range v.([]interface{}) {
+			if filename, ok := filenameRaw.(string); ok && filename != "" {
+				config.SharedConfigFiles = append(config.SharedConfigFiles, filename)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("shared_credentials_files"); ok {
+		for _, filenameRaw := range v.([]interface{}) {
+			if filename, ok := filenameRaw.(string); ok && filename != "" {
+				config.SharedCredentialsFiles = append(config.SharedCredentialsFiles, filename)
+			}
+		}
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1627,6 +1839,67 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"access_key", "secret_key"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validateDurationBetween(15*time.Minute, 12*time.Hour),
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+					ValidateFunc: validation.StringIsJSON,
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateArn,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("AWS_ROLE_ARN", ""),
+					Description:  "Amazon Resource Name of an IAM Role to assume prior to making API calls.",
+					ValidateFunc: validateArn,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("AWS_ROLE_SESSION_NAME", ""),
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Description:   "The OAuth 2.0 access token or OpenID Connect ID token that is provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					DefaultFunc:   schema.EnvDefaultFunc("AWS_WEB_IDENTITY_TOKEN_FILE", ""),
+					Description:   "File containing the OAuth 2.0 access token or OpenID Connect ID token that is provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1659,9 +1932,53 @@ func expandProviderDefaultTags(l []interface{}) *keyvaluetags.DefaultConfig {
 	if v, ok := m["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = keyvaluetags.New(v)
 	}
+
+	if v, ok := m["propagate_to_launched_instances"].(bool); ok {
+		defaultConfig.PropagateToLaunchedInstances = v
+	}
+
 	return defaultConfig
 }
 
+func expandProviderAssumeRoleWithWebIdentity(m map[string]interface{}) *AssumeRoleWithWebIdentity {
+	assumeRole := &AssumeRoleWithWebIdentity{}
+
+	if v, ok := m["duration"].(string); ok && v != "" {
+		// Validated by the schema.
+		duration, _ := time.ParseDuration(v)
+		assumeRole.Duration = duration
+	}
+
+	if v, ok := m["policy"].(string); ok && v != "" {
+		assumeRole.Policy = v
+	}
+
+	if v, ok := m["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+		for _, policyARNRaw := range v.List() {
+			if policyARN, ok := policyARNRaw.(string); ok {
+				assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN)
+			}
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	// An explicit token takes precedence over the AWS_WEB_IDENTITY_TOKEN_FILE environment variable.
+	if v, ok := m["web_identity_token"].(string); ok && v != "" {
+		assumeRole.WebIdentityToken = v
+	} else if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+		assumeRole.WebIdentityTokenFile = v
+	}
+
+	return assumeRole
+}
+
 func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1681,6 +1998,39 @@ func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 	return ignoreConfig
 }
 
+func expandProviderRequiredTags(l []interface{}) (*keyvaluetags.RequiredConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	requiredConfig := &keyvaluetags.RequiredConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["enforcement"].(string); ok && v != "" {
+		requiredConfig.Enforcement = v
+	}
+
+	if v, ok := m["keys"].(*schema.Set); ok {
+		requiredConfig.Keys = keyvaluetags.New(v.List())
+	}
+
+	if v, ok := m["key_patterns"].(map[string]interface{}); ok && len(v) > 0 {
+		requiredConfig.KeyPatterns = make(map[string]*regexp.Regexp, len(v))
+
+		for key, patternRaw := range v {
+			pattern, err := regexp.Compile(patternRaw.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("error compiling required_tags key_patterns (%s) regular expression: %w", key, err)
+			}
+
+			requiredConfig.KeyPatterns[key] = pattern
+		}
+	}
+
+	return requiredConfig, nil
+}
+
 // ReverseDns switches a DNS hostname to reverse DNS and vice-versa.
 func ReverseDns(hostname string) string {
 	parts := strings.Split(hostname, ".")
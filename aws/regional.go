@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// regionalImportIDSeparator separates the resource identifier from the region in import IDs,
+// e.g. my-log-group@us-west-2.
+const regionalImportIDSeparator = "@"
+
+var regionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+$`)
+
+// regionalClientCache lazily builds and caches the clients for regions used by resources
+// and data sources that override the provider region.
+type regionalClientCache struct {
+	clients              map[string]*AWSClient
+	mutex                sync.Mutex
+	newClient            func(region string) *AWSClient
+	partition            string
+	skipRegionValidation bool
+}
+
+// client returns the cached client for the specified region, building it if necessary.
+func (cache *regionalClientCache) client(region string) (*AWSClient, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if client, ok := cache.clients[region]; ok {
+		return client, nil
+	}
+
+	if err := cache.validateRegion(region); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Configuring AWS client for region (%s)", region)
+
+	client := cache.newClient(region)
+	client.regionalClients = cache
+	cache.clients[region] = client
+
+	return client, nil
+}
+
+// validateRegion statically validates that the region is in the provider partition.
+func (cache *regionalClientCache) validateRegion(region string) error {
+	if !cache.skipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return err
+		}
+	}
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && p.ID() != cache.partition {
+		return fmt.Errorf("region (%s) is not in the provider partition (%s)", region, cache.partition)
+	}
+
+	return nil
+}
+
+// RegionalClient returns the client for the specified region, which shares the provider
+// session and credentials. An empty region returns the provider client.
+func (client *AWSClient) RegionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.region {
+		return client, nil
+	}
+
+	if client.regionalClients == nil {
+		return nil, fmt.Errorf("region (%s) is not supported by the provider client", region)
+	}
+
+	return client.regionalClients.client(region)
+}
+
+// regionalMeta returns the client for the region argument of the resource or data source.
+func regionalMeta(d interface{ Get(string) interface{} }, meta interface{}) (interface{}, error) {
+	client, err := meta.(*AWSClient).RegionalClient(d.Get("region").(string))
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring AWS client: %w", err)
+	}
+
+	return client, nil
+}
+
+// parseRegionalImportID splits an import ID of the form ID@REGION.
+// IDs without a valid region suffix are returned unchanged.
+func parseRegionalImportID(importID string) (string, string) {
+	i := strings.LastIndex(importID, regionalImportIDSeparator)
+
+	if i < 0 {
+		return importID, ""
+	}
+
+	if region := importID[i+len(regionalImportIDSeparator):]; regionRegexp.MatchString(region) {
+		return importID[:i], region
+	}
+
+	return importID, ""
+}
+
+// regionalResource adds an optional region argument to the resource, overriding the provider region.
+// The resource CRUD, import and diff customization functions are called with the client for that region.
+func regionalResource(r *schema.Resource) *schema.Resource {
+	r.Schema["region"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringMatch(regionRegexp, "must be a valid AWS Region name"),
+	}
+
+	r.Create = regionalCRUDFunc(r.Create)
+	r.Read = regionalCRUDFunc(r.Read)
+	r.Update = regionalCRUDFunc(r.Update)
+	r.Delete = regionalCRUDFunc(r.Delete)
+	r.CreateContext = regionalCRUDContextFunc(r.CreateContext)
+	r.ReadContext = regionalCRUDContextFunc(r.ReadContext)
+	r.UpdateContext = regionalCRUDContextFunc(r.UpdateContext)
+	r.DeleteContext = regionalCRUDContextFunc(r.DeleteContext)
+
+	if r.Importer != nil {
+		r.Importer = &schema.ResourceImporter{
+			State:        regionalImporterStateFunc(r.Importer.State),
+			StateContext: regionalImporterStateContextFunc(r.Importer.StateContext),
+		}
+	}
+
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customdiff.Sequence(resourceRegionCustomizeDiff, regionalCustomizeDiffFunc(r.CustomizeDiff))
+	} else {
+		r.CustomizeDiff = resourceRegionCustomizeDiff
+	}
+
+	return r
+}
+
+// regionalDataSource adds an optional region argument to the data source, overriding the provider region.
+func regionalDataSource(r *schema.Resource) *schema.Resource {
+	r.Schema["region"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringMatch(regionRegexp, "must be a valid AWS Region name"),
+	}
+
+	r.Read = regionalCRUDFunc(r.Read)
+	r.ReadContext = regionalCRUDContextFunc(r.ReadContext)
+
+	return r
+}
+
+// resourceRegionCustomizeDiff suppresses region changes that do not change the region the resource
+// is managed in, e.g. configuring the provider region for a resource created without a region,
+// and validates a configured region during plan.
+func resourceRegionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("region") {
+		return nil
+	}
+
+	client := meta.(*AWSClient)
+	o, n := diff.GetChange("region")
+
+	if diff.Id() != "" && regionOrDefault(o.(string), client.region) == regionOrDefault(n.(string), client.region) {
+		return diff.Clear("region")
+	}
+
+	region := n.(string)
+
+	if region == "" || region == client.region || client.regionalClients == nil {
+		return nil
+	}
+
+	return client.regionalClients.validateRegion(region)
+}
+
+// regionOrDefault returns the region, or the default region if the region is empty.
+func regionOrDefault(region, defaultRegion string) string {
+	if region == "" {
+		return defaultRegion
+	}
+
+	return region
+}
+
+func regionalCRUDFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		meta, err := regionalMeta(d, meta)
+
+		if err != nil {
+			return err
+		}
+
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		setRegion(d, meta)
+
+		return nil
+	}
+}
+
+func regionalCRUDContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		meta, err := regionalMeta(d, meta)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags := f(ctx, d, meta)
+
+		if !diags.HasError() {
+			setRegion(d, meta)
+		}
+
+		return diags
+	}
+}
+
+// setRegion sets the region argument to the region of the client used by the resource or data source.
+func setRegion(d *schema.ResourceData, meta interface{}) {
+	if d.Id() != "" {
+		d.Set("region", meta.(*AWSClient).region)
+	}
+}
+
+func regionalImporterStateFunc(f schema.StateFunc) schema.StateFunc {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		meta, err := regionalImport(d, meta)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return f(d, meta)
+	}
+}
+
+func regionalImporterStateContextFunc(f schema.StateContextFunc) schema.StateContextFunc {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		meta, err := regionalImport(d, meta)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// regionalImport removes any region suffix from the import ID, setting the region argument,
+// and returns the client for the region.
+func regionalImport(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	if id, region := parseRegionalImportID(d.Id()); region != "" {
+		d.SetId(id)
+		d.Set("region", region)
+	}
+
+	return regionalMeta(d, meta)
+}
+
+func regionalCustomizeDiffFunc(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		meta, err := regionalMeta(diff, meta)
+
+		if err != nil {
+			return err
+		}
+
+		return f(ctx, diff, meta)
+	}
+}
@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestParseRegionalImportID(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		ImportID       string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{
+			Name:       "no region",
+			ImportID:   "my-log-group",
+			ExpectedID: "my-log-group",
+		},
+		{
+			Name:           "region",
+			ImportID:       "my-log-group@us-west-2", //lintignore:AWSAT003
+			ExpectedID:     "my-log-group",
+			ExpectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:           "GovCloud region",
+			ImportID:       "arn:aws-us-gov:sns:us-gov-west-1:123456789012:my-topic@us-gov-east-1", //lintignore:AWSAT003,AWSAT005
+			ExpectedID:     "arn:aws-us-gov:sns:us-gov-west-1:123456789012:my-topic",               //lintignore:AWSAT003,AWSAT005
+			ExpectedRegion: "us-gov-east-1",                                                        //lintignore:AWSAT003
+		},
+		{
+			Name:       "non-region suffix",
+			ImportID:   "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			Name:           "multiple separators",
+			ImportID:       "user@example.com@eu-central-1", //lintignore:AWSAT003
+			ExpectedID:     "user@example.com",
+			ExpectedRegion: "eu-central-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			gotID, gotRegion := parseRegionalImportID(testCase.ImportID)
+
+			if gotID != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", gotID, testCase.ExpectedID)
+			}
+
+			if gotRegion != testCase.ExpectedRegion {
+				t.Errorf("got region %s, expected %s", gotRegion, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	var built []string
+
+	client := &AWSClient{
+		region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	client.regionalClients = &regionalClientCache{
+		clients: map[string]*AWSClient{client.region: client},
+		newClient: func(region string) *AWSClient {
+			built = append(built, region)
+
+			return &AWSClient{region: region}
+		},
+		partition: "aws",
+	}
+
+	if got, err := client.RegionalClient(""); err != nil || got != client {
+		t.Fatalf("expected provider client for empty region, got %v (%v)", got, err)
+	}
+
+	if got, err := client.RegionalClient("us-west-2"); err != nil || got != client { //lintignore:AWSAT003
+		t.Fatalf("expected provider client for provider region, got %v (%v)", got, err)
+	}
+
+	regionalClient, err := client.RegionalClient("eu-west-1") //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if regionalClient.region != "eu-west-1" { //lintignore:AWSAT003
+		t.Errorf("got region %s, expected eu-west-1", regionalClient.region)
+	}
+
+	if got, err := regionalClient.RegionalClient("eu-west-1"); err != nil || got != regionalClient { //lintignore:AWSAT003
+		t.Fatalf("expected cached regional client, got %v (%v)", got, err)
+	}
+
+	if got, err := regionalClient.RegionalClient("us-west-2"); err != nil || got != client { //lintignore:AWSAT003
+		t.Fatalf("expected provider client from regional client, got %v (%v)", got, err)
+	}
+
+	if len(built) != 1 {
+		t.Errorf("expected 1 regional client to be built, got %d", len(built))
+	}
+
+	if _, err := client.RegionalClient("cn-north-1"); err == nil { //lintignore:AWSAT003
+		t.Errorf("expected error for region in another partition")
+	}
+
+	if _, err := client.RegionalClient("not-a-region-1"); err == nil {
+		t.Errorf("expected error for invalid region")
+	}
+}
+
+func TestRegionalResourceDiff(t *testing.T) {
+	client := &AWSClient{
+		region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	client.regionalClients = &regionalClientCache{
+		clients: map[string]*AWSClient{client.region: client},
+		newClient: func(region string) *AWSClient {
+			return &AWSClient{region: region}
+		},
+		partition: "aws",
+	}
+
+	resource := regionalResource(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	})
+
+	testCases := []struct {
+		Name              string
+		State             map[string]string
+		Config            map[string]interface{}
+		ExpectError       bool
+		ExpectRegionDiff  bool
+		ExpectRequiresNew bool
+	}{
+		{
+			Name:   "create without region",
+			Config: map[string]interface{}{"name": "test"},
+		},
+		{
+			Name:             "create with region",
+			Config:           map[string]interface{}{"name": "test", "region": "eu-west-1"}, //lintignore:AWSAT003
+			ExpectRegionDiff: true,
+		},
+		{
+			Name:        "create with region in another partition",
+			Config:      map[string]interface{}{"name": "test", "region": "cn-north-1"}, //lintignore:AWSAT003
+			ExpectError: true,
+		},
+		{
+			Name:   "provider region added to state without region",
+			State:  map[string]string{"id": "test", "name": "test"},
+			Config: map[string]interface{}{"name": "test", "region": "us-west-2"}, //lintignore:AWSAT003
+		},
+		{
+			Name:   "region removed",
+			State:  map[string]string{"id": "test", "name": "test", "region": "us-west-2"}, //lintignore:AWSAT003
+			Config: map[string]interface{}{"name": "test"},
+		},
+		{
+			Name:              "region changed",
+			State:             map[string]string{"id": "test", "name": "test", "region": "us-west-2"}, //lintignore:AWSAT003
+			Config:            map[string]interface{}{"name": "test", "region": "eu-west-1"},          //lintignore:AWSAT003
+			ExpectRegionDiff:  true,
+			ExpectRequiresNew: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var state *terraform.InstanceState
+
+			if testCase.State != nil {
+				state = &terraform.InstanceState{
+					ID:         testCase.State["id"],
+					Attributes: testCase.State,
+				}
+			}
+
+			diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(testCase.Config), client)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var regionDiff *terraform.ResourceAttrDiff
+
+			if diff != nil {
+				regionDiff = diff.Attributes["region"]
+			}
+
+			if got, expected := regionDiff != nil && regionDiff.Old != regionDiff.New, testCase.ExpectRegionDiff; got != expected {
+				t.Errorf("got region diff %t (%v), expected %t", got, regionDiff, expected)
+			}
+
+			if state == nil {
+				return
+			}
+
+			if got, expected := diff != nil && diff.RequiresNew(), testCase.ExpectRequiresNew; got != expected {
+				t.Errorf("got requires new %t, expected %t", got, expected)
+			}
+		})
+	}
+}
@@ -430,6 +430,12 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 				},
 			},
 
+			"propagated_default_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"tag": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -598,6 +604,7 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			autoscalingGroupPropagatedDefaultTagsDiff,
 		),
 	}
 }
@@ -699,12 +706,18 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 		createOpts.AvailabilityZones = expandStringSet(v.(*schema.Set))
 	}
 
+	tags := autoscalingGroupDefaultTags(keyvaluetags.New(d.Get("propagated_default_tags").(map[string]interface{})), asgName)
+
 	if v, ok := d.GetOk("tag"); ok {
-		createOpts.Tags = keyvaluetags.AutoscalingKeyValueTags(v, asgName, autoscalingTagResourceTypeAutoScalingGroup).IgnoreAws().AutoscalingTags()
+		tags = tags.Merge(keyvaluetags.AutoscalingKeyValueTags(v, asgName, autoscalingTagResourceTypeAutoScalingGroup))
 	}
 
 	if v, ok := d.GetOk("tags"); ok {
-		createOpts.Tags = keyvaluetags.AutoscalingKeyValueTags(v, asgName, autoscalingTagResourceTypeAutoScalingGroup).IgnoreAws().AutoscalingTags()
+		tags = tags.Merge(keyvaluetags.AutoscalingKeyValueTags(v, asgName, autoscalingTagResourceTypeAutoScalingGroup))
+	}
+
+	if len(tags) > 0 {
+		createOpts.Tags = tags.IgnoreAws().AutoscalingTags()
 	}
 
 	if v, ok := d.GetOk("capacity_rebalance"); ok {
@@ -826,6 +839,7 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 // TODO: wrap all top-level error returns
 func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).autoscalingconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	g, err := getAwsAutoscalingGroup(d.Id(), conn)
@@ -908,8 +922,29 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	// Previously propagated default tags are included so that keys since removed
+	// from the provider default_tags configuration block are removed on update.
+	tags := keyvaluetags.AutoscalingKeyValueTags(g.Tags, d.Id(), autoscalingTagResourceTypeAutoScalingGroup)
+	propagatedDefaultTags := make(map[string]string)
+	defaultTagKeys := keyvaluetags.New(d.Get("propagated_default_tags").(map[string]interface{})).Merge(defaultTagsConfig.LaunchedInstanceTags())
+
+	for _, key := range defaultTagKeys.Removed(autoscalingGroupConfiguredTags(d.Get("tag"), d.Get("tags"))).Keys() {
+		if v := tags.KeyValue(key); v != nil && aws.BoolValue(tags.KeyAdditionalBoolValue(key, "PropagateAtLaunch")) {
+			propagatedDefaultTags[key] = aws.StringValue(v)
+		}
+	}
+
+	if err := d.Set("propagated_default_tags", propagatedDefaultTags); err != nil {
+		return fmt.Errorf("error setting propagated_default_tags: %w", err)
+	}
+
 	if !tagOk && !tagsOk {
-		if err := d.Set("tag", keyvaluetags.AutoscalingKeyValueTags(g.Tags, d.Id(), autoscalingTagResourceTypeAutoScalingGroup).IgnoreAws().IgnoreConfig(ignoreTagsConfig).AutoscalingListOfMap()); err != nil {
+		// Default tags propagated to launched instances are not configured in the resource.
+		tags := tags.RemoveDefaultConfig(&keyvaluetags.DefaultConfig{
+			Tags: autoscalingGroupDefaultTags(keyvaluetags.New(propagatedDefaultTags), d.Id()),
+		})
+
+		if err := d.Set("tag", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).AutoscalingListOfMap()); err != nil {
 			return fmt.Errorf("error setting tag: %w", err)
 		}
 	}
@@ -1116,17 +1151,20 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 		opts.ServiceLinkedRoleARN = aws.String(d.Get("service_linked_role_arn").(string))
 	}
 
-	if d.HasChanges("tag", "tags") {
+	if d.HasChanges("propagated_default_tags", "tag", "tags") {
+		oDefaultTagsRaw, nDefaultTagsRaw := d.GetChange("propagated_default_tags")
 		oTagRaw, nTagRaw := d.GetChange("tag")
 		oTagsRaw, nTagsRaw := d.GetChange("tags")
 
+		oDefaultTags := autoscalingGroupDefaultTags(keyvaluetags.New(oDefaultTagsRaw.(map[string]interface{})), d.Id())
 		oTag := keyvaluetags.AutoscalingKeyValueTags(oTagRaw, d.Id(), autoscalingTagResourceTypeAutoScalingGroup)
 		oTags := keyvaluetags.AutoscalingKeyValueTags(oTagsRaw, d.Id(), autoscalingTagResourceTypeAutoScalingGroup)
-		oldTags := oTag.Merge(oTags).AutoscalingTags()
+		oldTags := oDefaultTags.Merge(oTag.Merge(oTags)).AutoscalingTags()
 
+		nDefaultTags := autoscalingGroupDefaultTags(keyvaluetags.New(nDefaultTagsRaw.(map[string]interface{})), d.Id())
 		nTag := keyvaluetags.AutoscalingKeyValueTags(nTagRaw, d.Id(), autoscalingTagResourceTypeAutoScalingGroup)
 		nTags := keyvaluetags.AutoscalingKeyValueTags(nTagsRaw, d.Id(), autoscalingTagResourceTypeAutoScalingGroup)
-		newTags := nTag.Merge(nTags).AutoscalingTags()
+		newTags := nDefaultTags.Merge(nTag.Merge(nTags)).AutoscalingTags()
 
 		if err := keyvaluetags.AutoscalingUpdateTags(conn, d.Id(), autoscalingTagResourceTypeAutoScalingGroup, oldTags, newTags); err != nil {
 			return fmt.Errorf("error updating tags for Auto Scaling Group (%s): %w", d.Id(), err)
@@ -1847,6 +1885,49 @@ func getTargetGroupInstanceStates(g *autoscaling.Group, meta interface{}) (map[s
 	return targetInstanceStates, nil
 }
 
+// autoscalingGroupDefaultTags returns the provider default tags to propagate to instances
+// launched by the Auto Scaling Group as Auto Scaling Group tags.
+func autoscalingGroupDefaultTags(defaultTags keyvaluetags.KeyValueTags, asgName string) keyvaluetags.KeyValueTags {
+	if len(defaultTags) == 0 {
+		return nil
+	}
+
+	tags := make([]*autoscaling.Tag, 0, len(defaultTags))
+
+	for k, v := range defaultTags.Map() {
+		tags = append(tags, &autoscaling.Tag{
+			Key:               aws.String(k),
+			PropagateAtLaunch: aws.Bool(true),
+			Value:             aws.String(v),
+		})
+	}
+
+	return keyvaluetags.AutoscalingKeyValueTags(tags, asgName, autoscalingTagResourceTypeAutoScalingGroup)
+}
+
+// autoscalingGroupConfiguredTags returns the tags configured in the tag and tags arguments.
+func autoscalingGroupConfiguredTags(tag, tags interface{}) keyvaluetags.KeyValueTags {
+	return keyvaluetags.AutoscalingKeyValueTags(tag, "", autoscalingTagResourceTypeAutoScalingGroup).Merge(keyvaluetags.AutoscalingKeyValueTags(tags, "", autoscalingTagResourceTypeAutoScalingGroup))
+}
+
+// autoscalingGroupPropagatedDefaultTagsDiff plans the provider default tags to propagate to launched instances,
+// excluding those overridden in the resource, so that changes to the provider default_tags configuration block
+// are applied to the Auto Scaling Group.
+func autoscalingGroupPropagatedDefaultTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	defaultTags := meta.(*AWSClient).DefaultTagsConfig.LaunchedInstanceTags().IgnoreAws().Removed(autoscalingGroupConfiguredTags(diff.Get("tag"), diff.Get("tags")))
+	propagatedDefaultTags := keyvaluetags.New(diff.Get("propagated_default_tags").(map[string]interface{}))
+
+	if diff.Id() != "" && propagatedDefaultTags.ContainsAll(defaultTags) && defaultTags.ContainsAll(propagatedDefaultTags) {
+		return nil
+	}
+
+	if err := diff.SetNew("propagated_default_tags", defaultTags.Map()); err != nil {
+		return fmt.Errorf("error setting new propagated_default_tags diff: %w", err)
+	}
+
+	return nil
+}
+
 func expandVpcZoneIdentifiers(list []interface{}) *string {
 	strs := make([]string, len(list))
 	for i, s := range list {
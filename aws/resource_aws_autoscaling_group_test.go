@@ -384,6 +384,60 @@ func TestAccAWSAutoScalingGroup_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoScalingGroup_DefaultTags_PropagateToLaunchedInstances(t *testing.T) {
+	var group autoscaling.Group
+	resourceName := "aws_autoscaling_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(
+					testAccAWSAutoScalingGroupConfigProviderDefaultTagsPropagate("defaultkey1", "defaultvalue1"),
+					testAccAWSAutoScalingGroupConfigDefaultTags(rName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.defaultkey1", "defaultvalue1"),
+					testAccCheckAutoscalingTags(&group.Tags, "defaultkey1", map[string]interface{}{
+						"value":               "defaultvalue1",
+						"propagate_at_launch": true,
+					}),
+				),
+			},
+			{
+				Config: composeConfig(
+					testAccAWSAutoScalingGroupConfigProviderDefaultTagsPropagate("defaultkey2", "defaultvalue2"),
+					testAccAWSAutoScalingGroupConfigDefaultTags(rName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.defaultkey2", "defaultvalue2"),
+					testAccCheckAutoscalingTagNotExists(&group.Tags, "defaultkey1"),
+					testAccCheckAutoscalingTags(&group.Tags, "defaultkey2", map[string]interface{}{
+						"value":               "defaultvalue2",
+						"propagate_at_launch": true,
+					}),
+				),
+			},
+			{
+				Config: testAccAWSAutoScalingGroupConfigDefaultTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.%", "0"),
+					testAccCheckAutoscalingTagNotExists(&group.Tags, "defaultkey2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAutoScalingGroup_VpcUpdates(t *testing.T) {
 	var group autoscaling.Group
 
@@ -2468,6 +2522,44 @@ resource "aws_autoscaling_group" "bar" {
 `)
 }
 
+func testAccAWSAutoScalingGroupConfigProviderDefaultTagsPropagate(key1, value1 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    propagate_to_launched_instances = true
+
+    tags = {
+      %[1]q = %[2]q
+    }
+  }
+}
+`, key1, value1)
+}
+
+func testAccAWSAutoScalingGroupConfigDefaultTags(rName string) string {
+	return composeConfig(testAccLatestAmazonLinuxHvmEbsAmiConfig(), testAccAvailableAZsNoOptInDefaultExcludeConfig(), fmt.Sprintf(`
+resource "aws_launch_configuration" "test" {
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t2.micro"
+}
+
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  launch_configuration = aws_launch_configuration.test.name
+  max_size             = 0
+  min_size             = 0
+  name                 = %[1]q
+
+  tag {
+    key                 = "Name"
+    value               = %[1]q
+    propagate_at_launch = true
+  }
+}
+`, rName))
+}
+
 func testAccAWSAutoScalingGroupConfig(name string) string {
 	return testAccAvailableAZsNoOptInDefaultExcludeConfig() +
 		fmt.Sprintf(`
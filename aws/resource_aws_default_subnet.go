@@ -51,6 +51,29 @@ func resourceAwsDefaultSubnet() *schema.Resource {
 		Type:     schema.TypeBool,
 		Computed: true,
 	}
+	// ipv6_native is a computed value for Default Subnets
+	dsubnet.Schema["ipv6_native"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+	// enable_dns64 is a computed value for Default Subnets
+	dsubnet.Schema["enable_dns64"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+	}
+	// enable_resource_name_dns_aaaa_record_on_launch is a computed value for Default Subnets
+	dsubnet.Schema["enable_resource_name_dns_aaaa_record_on_launch"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+	}
+	// enable_resource_name_dns_a_record_on_launch is a computed value for Default Subnets
+	dsubnet.Schema["enable_resource_name_dns_a_record_on_launch"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+	}
 
 	return dsubnet
 }
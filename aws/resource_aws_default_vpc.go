@@ -30,6 +30,9 @@ func resourceAwsDefaultVpc() *schema.Resource {
 		Type:     schema.TypeBool,
 		Computed: true,
 	}
+	// Default VPCs are not allocated from IPAM pools
+	delete(dvpc.Schema, "ipv4_ipam_pool_id")
+	delete(dvpc.Schema, "ipv4_netmask_length")
 
 	return dvpc
 }
@@ -92,6 +92,13 @@ func resourceAwsEc2TransitGateway() *schema.Resource {
 					ec2.DnsSupportValueEnable,
 				}, false),
 			},
+			"multicast_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.MulticastSupportValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.MulticastSupportValue_Values(), false),
+			},
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -102,6 +109,15 @@ func resourceAwsEc2TransitGateway() *schema.Resource {
 			},
 			"tags":     tagsSchema(),
 			"tags_all": tagsSchemaComputed(),
+			"transit_gateway_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDRNetworkAddress,
+				},
+			},
 			"vpn_ecmp_support": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -126,6 +142,7 @@ func resourceAwsEc2TransitGatewayCreate(d *schema.ResourceData, meta interface{}
 			DefaultRouteTableAssociation: aws.String(d.Get("default_route_table_association").(string)),
 			DefaultRouteTablePropagation: aws.String(d.Get("default_route_table_propagation").(string)),
 			DnsSupport:                   aws.String(d.Get("dns_support").(string)),
+			MulticastSupport:             aws.String(d.Get("multicast_support").(string)),
 			VpnEcmpSupport:               aws.String(d.Get("vpn_ecmp_support").(string)),
 		},
 		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeTransitGateway),
@@ -139,6 +156,10 @@ func resourceAwsEc2TransitGatewayCreate(d *schema.ResourceData, meta interface{}
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("transit_gateway_cidr_blocks"); ok && v.(*schema.Set).Len() > 0 {
+		input.Options.TransitGatewayCidrBlocks = expandStringSet(v.(*schema.Set))
+	}
+
 	log.Printf("[DEBUG] Creating EC2 Transit Gateway: %s", input)
 	output, err := conn.CreateTransitGateway(input)
 	if err != nil {
@@ -195,6 +216,7 @@ func resourceAwsEc2TransitGatewayRead(d *schema.ResourceData, meta interface{})
 	d.Set("default_route_table_propagation", transitGateway.Options.DefaultRouteTablePropagation)
 	d.Set("description", transitGateway.Description)
 	d.Set("dns_support", transitGateway.Options.DnsSupport)
+	d.Set("multicast_support", transitGateway.Options.MulticastSupport)
 	d.Set("owner_id", transitGateway.OwnerId)
 	d.Set("propagation_default_route_table_id", transitGateway.Options.PropagationDefaultRouteTableId)
 
@@ -209,6 +231,10 @@ func resourceAwsEc2TransitGatewayRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error setting tags_all: %w", err)
 	}
 
+	if err := d.Set("transit_gateway_cidr_blocks", aws.StringValueSlice(transitGateway.Options.TransitGatewayCidrBlocks)); err != nil {
+		return fmt.Errorf("error setting transit_gateway_cidr_blocks: %w", err)
+	}
+
 	d.Set("vpn_ecmp_support", transitGateway.Options.VpnEcmpSupport)
 
 	return nil
@@ -247,6 +273,22 @@ func resourceAwsEc2TransitGatewayUpdate(d *schema.ResourceData, meta interface{}
 		options.DnsSupport = aws.String(d.Get("dns_support").(string))
 	}
 
+	if d.HasChange("transit_gateway_cidr_blocks") {
+		transitGatewayModified = true
+
+		oldRaw, newRaw := d.GetChange("transit_gateway_cidr_blocks")
+		oldSet := oldRaw.(*schema.Set)
+		newSet := newRaw.(*schema.Set)
+
+		if added := newSet.Difference(oldSet); added.Len() > 0 {
+			options.AddTransitGatewayCidrBlocks = expandStringSet(added)
+		}
+
+		if removed := oldSet.Difference(newSet); removed.Len() > 0 {
+			options.RemoveTransitGatewayCidrBlocks = expandStringSet(removed)
+		}
+	}
+
 	if d.HasChange("vpn_ecmp_support") {
 		transitGatewayModified = true
 		options.VpnEcmpSupport = aws.String(d.Get("vpn_ecmp_support").(string))
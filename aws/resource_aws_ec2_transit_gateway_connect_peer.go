@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEc2TransitGatewayConnectPeer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayConnectPeerCreate,
+		Read:   resourceAwsEc2TransitGatewayConnectPeerRead,
+		Update: resourceAwsEc2TransitGatewayConnectPeerUpdate,
+		Delete: resourceAwsEc2TransitGatewayConnectPeerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_asn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate4ByteAsn,
+			},
+			"inside_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDRNetworkAddress,
+				},
+			},
+			"peer_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"transit_gateway_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"transit_gateway_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateTransitGatewayConnectPeerInput{
+		InsideCidrBlocks:           expandStringSet(d.Get("inside_cidr_blocks").(*schema.Set)),
+		PeerAddress:                aws.String(d.Get("peer_address").(string)),
+		TagSpecifications:          ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeTransitGatewayConnectPeer),
+		TransitGatewayAttachmentId: aws.String(d.Get("transit_gateway_attachment_id").(string)),
+	}
+
+	if v, ok := d.GetOk("bgp_asn"); ok {
+		v, err := strconv.ParseInt(v.(string), 10, 64)
+
+		if err != nil {
+			return err
+		}
+
+		input.BgpOptions = &ec2.TransitGatewayConnectRequestBgpOptions{
+			PeerAsn: aws.Int64(v),
+		}
+	}
+
+	if v, ok := d.GetOk("transit_gateway_address"); ok {
+		input.TransitGatewayAddress = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Connect Peer: %s", input)
+	output, err := conn.CreateTransitGatewayConnectPeer(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Connect Peer: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayConnectPeer.TransitGatewayConnectPeerId))
+
+	if _, err := waiter.TransitGatewayConnectPeerCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Peer (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsEc2TransitGatewayConnectPeerRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	transitGatewayConnectPeer, err := finder.TransitGatewayConnectPeerByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Peer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connect Peer (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("transit-gateway-connect-peer/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	if configuration := transitGatewayConnectPeer.ConnectPeerConfiguration; configuration != nil {
+		if len(configuration.BgpConfigurations) > 0 && configuration.BgpConfigurations[0] != nil {
+			d.Set("bgp_asn", strconv.FormatInt(aws.Int64Value(configuration.BgpConfigurations[0].PeerAsn), 10))
+		} else {
+			d.Set("bgp_asn", nil)
+		}
+
+		if err := d.Set("inside_cidr_blocks", aws.StringValueSlice(configuration.InsideCidrBlocks)); err != nil {
+			return fmt.Errorf("error setting inside_cidr_blocks: %w", err)
+		}
+
+		d.Set("peer_address", configuration.PeerAddress)
+		d.Set("transit_gateway_address", configuration.TransitGatewayAddress)
+	}
+
+	tags := keyvaluetags.Ec2KeyValueTags(transitGatewayConnectPeer.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	d.Set("transit_gateway_attachment_id", transitGatewayConnectPeer.TransitGatewayAttachmentId)
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Connect Peer (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2TransitGatewayConnectPeerRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Connect Peer: %s", d.Id())
+	_, err := conn.DeleteTransitGatewayConnectPeer(&ec2.DeleteTransitGatewayConnectPeerInput{
+		TransitGatewayConnectPeerId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayConnectPeerIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Connect Peer (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.TransitGatewayConnectPeerDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Peer (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEc2TransitGatewayMulticastDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayMulticastDomainCreate,
+		Read:   resourceAwsEc2TransitGatewayMulticastDomainRead,
+		Update: resourceAwsEc2TransitGatewayMulticastDomainUpdate,
+		Delete: resourceAwsEc2TransitGatewayMulticastDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_accept_shared_associations": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.AutoAcceptSharedAssociationsValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.AutoAcceptSharedAssociationsValue_Values(), false),
+			},
+			"igmpv2_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.Igmpv2SupportValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.Igmpv2SupportValue_Values(), false),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"static_sources_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.StaticSourcesSupportValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.StaticSourcesSupportValue_Values(), false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"transit_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateTransitGatewayMulticastDomainInput{
+		Options: &ec2.CreateTransitGatewayMulticastDomainRequestOptions{
+			AutoAcceptSharedAssociations: aws.String(d.Get("auto_accept_shared_associations").(string)),
+			Igmpv2Support:                aws.String(d.Get("igmpv2_support").(string)),
+			StaticSourcesSupport:         aws.String(d.Get("static_sources_support").(string)),
+		},
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeTransitGatewayMulticastDomain),
+		TransitGatewayId:  aws.String(d.Get("transit_gateway_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Multicast Domain: %s", input)
+	output, err := conn.CreateTransitGatewayMulticastDomain(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Multicast Domain: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayMulticastDomain.TransitGatewayMulticastDomainId))
+
+	if _, err := waiter.TransitGatewayMulticastDomainCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsEc2TransitGatewayMulticastDomainRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	multicastDomain, err := finder.TransitGatewayMulticastDomainByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", multicastDomain.TransitGatewayMulticastDomainArn)
+
+	if options := multicastDomain.Options; options != nil {
+		d.Set("auto_accept_shared_associations", options.AutoAcceptSharedAssociations)
+		d.Set("igmpv2_support", options.Igmpv2Support)
+		d.Set("static_sources_support", options.StaticSourcesSupport)
+	}
+
+	d.Set("owner_id", multicastDomain.OwnerId)
+
+	tags := keyvaluetags.Ec2KeyValueTags(multicastDomain.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	d.Set("transit_gateway_id", multicastDomain.TransitGatewayId)
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Multicast Domain (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2TransitGatewayMulticastDomainRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Multicast Domain: %s", d.Id())
+	_, err := conn.DeleteTransitGatewayMulticastDomain(&ec2.DeleteTransitGatewayMulticastDomainInput{
+		TransitGatewayMulticastDomainId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Multicast Domain (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.TransitGatewayMulticastDomainDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
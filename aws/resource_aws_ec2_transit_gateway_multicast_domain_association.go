@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEc2TransitGatewayMulticastDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayMulticastDomainAssociationCreate,
+		Read:   resourceAwsEc2TransitGatewayMulticastDomainAssociationRead,
+		Delete: resourceAwsEc2TransitGatewayMulticastDomainAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_multicast_domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID := d.Get("transit_gateway_multicast_domain_id").(string)
+	attachmentID := d.Get("transit_gateway_attachment_id").(string)
+	subnetID := d.Get("subnet_id").(string)
+	id := tfec2.TransitGatewayMulticastDomainAssociationCreateID(multicastDomainID, attachmentID, subnetID)
+	input := &ec2.AssociateTransitGatewayMulticastDomainInput{
+		SubnetIds:                       aws.StringSlice([]string{subnetID}),
+		TransitGatewayAttachmentId:      aws.String(attachmentID),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Multicast Domain Association: %s", input)
+	_, err := conn.AssociateTransitGatewayMulticastDomain(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Multicast Domain Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.TransitGatewayMulticastDomainAssociationCreated(conn, multicastDomainID, attachmentID, subnetID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain Association (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsEc2TransitGatewayMulticastDomainAssociationRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID, attachmentID, subnetID, err := tfec2.TransitGatewayMulticastDomainAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	multicastDomainAssociation, err := finder.TransitGatewayMulticastDomainAssociationByThreePartKey(conn, multicastDomainID, attachmentID, subnetID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("subnet_id", multicastDomainAssociation.Subnet.SubnetId)
+	d.Set("transit_gateway_attachment_id", multicastDomainAssociation.TransitGatewayAttachmentId)
+	d.Set("transit_gateway_multicast_domain_id", multicastDomainID)
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID, attachmentID, subnetID, err := tfec2.TransitGatewayMulticastDomainAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Multicast Domain Association: %s", d.Id())
+	_, err = conn.DisassociateTransitGatewayMulticastDomain(&ec2.DisassociateTransitGatewayMulticastDomainInput{
+		SubnetIds:                       aws.StringSlice([]string{subnetID}),
+		TransitGatewayAttachmentId:      aws.String(attachmentID),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Multicast Domain Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.TransitGatewayMulticastDomainAssociationDeleted(conn, multicastDomainID, attachmentID, subnetID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
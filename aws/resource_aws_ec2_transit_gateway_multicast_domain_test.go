@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSEc2TransitGatewayMulticastDomain_basic(t *testing.T) {
+	var multicastDomain ec2.TransitGatewayMulticastDomain
+	resourceName := "aws_ec2_transit_gateway_multicast_domain.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayMulticastDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayMulticastDomainConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayMulticastDomainExists(resourceName, &multicastDomain),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`transit-gateway-multicast-domain/tgw-mcast-domain-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_accept_shared_associations", ec2.AutoAcceptSharedAssociationsValueDisable),
+					resource.TestCheckResourceAttr(resourceName, "igmpv2_support", ec2.Igmpv2SupportValueDisable),
+					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "static_sources_support", ec2.StaticSourcesSupportValueDisable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayMulticastDomain_disappears(t *testing.T) {
+	var multicastDomain ec2.TransitGatewayMulticastDomain
+	resourceName := "aws_ec2_transit_gateway_multicast_domain.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayMulticastDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayMulticastDomainConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayMulticastDomainExists(resourceName, &multicastDomain),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEc2TransitGatewayMulticastDomain(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayMulticastDomain_Options(t *testing.T) {
+	var multicastDomain ec2.TransitGatewayMulticastDomain
+	resourceName := "aws_ec2_transit_gateway_multicast_domain.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayMulticastDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayMulticastDomainConfigOptions(rName, ec2.AutoAcceptSharedAssociationsValueEnable, ec2.Igmpv2SupportValueEnable, ec2.StaticSourcesSupportValueDisable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayMulticastDomainExists(resourceName, &multicastDomain),
+					resource.TestCheckResourceAttr(resourceName, "auto_accept_shared_associations", ec2.AutoAcceptSharedAssociationsValueEnable),
+					resource.TestCheckResourceAttr(resourceName, "igmpv2_support", ec2.Igmpv2SupportValueEnable),
+					resource.TestCheckResourceAttr(resourceName, "static_sources_support", ec2.StaticSourcesSupportValueDisable),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayMulticastDomain_Tags(t *testing.T) {
+	var multicastDomain ec2.TransitGatewayMulticastDomain
+	resourceName := "aws_ec2_transit_gateway_multicast_domain.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayMulticastDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayMulticastDomainConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayMulticastDomainExists(resourceName, &multicastDomain),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TransitGatewayMulticastDomainConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayMulticastDomainExists(resourceName, &multicastDomain),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEc2TransitGatewayMulticastDomainConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayMulticastDomainExists(resourceName, &multicastDomain),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TransitGatewayMulticastDomainExists(n string, v *ec2.TransitGatewayMulticastDomain) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Multicast Domain ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.TransitGatewayMulticastDomainByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TransitGatewayMulticastDomainDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_multicast_domain" {
+			continue
+		}
+
+		_, err := finder.TransitGatewayMulticastDomainByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Transit Gateway Multicast Domain %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSEc2TransitGatewayMulticastDomainConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  multicast_support = "enable"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSEc2TransitGatewayMulticastDomainConfig(rName string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayMulticastDomainConfigBase(rName), `
+resource "aws_ec2_transit_gateway_multicast_domain" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+}
+`)
+}
+
+func testAccAWSEc2TransitGatewayMulticastDomainConfigOptions(rName, autoAcceptSharedAssociations, igmpv2Support, staticSourcesSupport string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayMulticastDomainConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_multicast_domain" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  auto_accept_shared_associations = %[1]q
+  igmpv2_support                  = %[2]q
+  static_sources_support          = %[3]q
+}
+`, autoAcceptSharedAssociations, igmpv2Support, staticSourcesSupport))
+}
+
+func testAccAWSEc2TransitGatewayMulticastDomainConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayMulticastDomainConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_multicast_domain" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSEc2TransitGatewayMulticastDomainConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayMulticastDomainConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_multicast_domain" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEc2TransitGatewayMulticastGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayMulticastGroupMemberCreate,
+		Read:   resourceAwsEc2TransitGatewayMulticastGroupMemberRead,
+		Delete: resourceAwsEc2TransitGatewayMulticastGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_multicast_domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayMulticastGroupMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID := d.Get("transit_gateway_multicast_domain_id").(string)
+	groupIPAddress := d.Get("group_ip_address").(string)
+	eniID := d.Get("network_interface_id").(string)
+	id := tfec2.TransitGatewayMulticastGroupCreateID(multicastDomainID, groupIPAddress, eniID)
+	input := &ec2.RegisterTransitGatewayMulticastGroupMembersInput{
+		GroupIpAddress:                  aws.String(groupIPAddress),
+		NetworkInterfaceIds:             aws.StringSlice([]string{eniID}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Multicast Group Member: %s", input)
+	_, err := conn.RegisterTransitGatewayMulticastGroupMembers(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Multicast Group Member (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsEc2TransitGatewayMulticastGroupMemberRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayMulticastGroupMemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID, groupIPAddress, eniID, err := tfec2.TransitGatewayMulticastGroupParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	multicastGroup, err := finder.TransitGatewayMulticastGroupMemberByThreePartKey(conn, multicastDomainID, groupIPAddress, eniID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Group Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Group Member (%s): %w", d.Id(), err)
+	}
+
+	d.Set("group_ip_address", multicastGroup.GroupIpAddress)
+	d.Set("network_interface_id", multicastGroup.NetworkInterfaceId)
+	d.Set("transit_gateway_multicast_domain_id", multicastDomainID)
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayMulticastGroupMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID, groupIPAddress, eniID, err := tfec2.TransitGatewayMulticastGroupParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Multicast Group Member: %s", d.Id())
+	_, err = conn.DeregisterTransitGatewayMulticastGroupMembers(&ec2.DeregisterTransitGatewayMulticastGroupMembersInput{
+		GroupIpAddress:                  aws.String(groupIPAddress),
+		NetworkInterfaceIds:             aws.StringSlice([]string{eniID}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Multicast Group Member (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
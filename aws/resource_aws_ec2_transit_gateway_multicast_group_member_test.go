@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSEc2TransitGatewayMulticastGroupMember_basic(t *testing.T) {
+	var multicastGroup ec2.TransitGatewayMulticastGroup
+	resourceName := "aws_ec2_transit_gateway_multicast_group_member.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayMulticastGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayMulticastGroupMemberConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayMulticastGroupMemberExists(resourceName, &multicastGroup),
+					resource.TestCheckResourceAttr(resourceName, "group_ip_address", "224.0.0.1"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", "aws_network_interface.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_multicast_domain_id", "aws_ec2_transit_gateway_multicast_domain.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayMulticastGroupMember_disappears(t *testing.T) {
+	var multicastGroup ec2.TransitGatewayMulticastGroup
+	resourceName := "aws_ec2_transit_gateway_multicast_group_member.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayMulticastGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayMulticastGroupMemberConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayMulticastGroupMemberExists(resourceName, &multicastGroup),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEc2TransitGatewayMulticastGroupMember(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TransitGatewayMulticastGroupMemberExists(n string, v *ec2.TransitGatewayMulticastGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Multicast Group Member ID is set")
+		}
+
+		multicastDomainID, groupIPAddress, eniID, err := tfec2.TransitGatewayMulticastGroupParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.TransitGatewayMulticastGroupMemberByThreePartKey(conn, multicastDomainID, groupIPAddress, eniID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TransitGatewayMulticastGroupMemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_multicast_group_member" {
+			continue
+		}
+
+		multicastDomainID, groupIPAddress, eniID, err := tfec2.TransitGatewayMulticastGroupParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.TransitGatewayMulticastGroupMemberByThreePartKey(conn, multicastDomainID, groupIPAddress, eniID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Transit Gateway Multicast Group Member %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSEc2TransitGatewayMulticastGroupConfigBase(rName, staticSourcesSupport string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayMulticastDomainAssociationConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_multicast_domain" "test" {
+  transit_gateway_id     = aws_ec2_transit_gateway.test.id
+  static_sources_support = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_multicast_domain_association" "test" {
+  subnet_id                           = aws_subnet.test.id
+  transit_gateway_attachment_id       = aws_ec2_transit_gateway_vpc_attachment.test.id
+  transit_gateway_multicast_domain_id = aws_ec2_transit_gateway_multicast_domain.test.id
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, staticSourcesSupport))
+}
+
+func testAccAWSEc2TransitGatewayMulticastGroupMemberConfig(rName string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayMulticastGroupConfigBase(rName, "disable"), `
+resource "aws_ec2_transit_gateway_multicast_group_member" "test" {
+  group_ip_address                    = "224.0.0.1"
+  network_interface_id                = aws_network_interface.test.id
+  transit_gateway_multicast_domain_id = aws_ec2_transit_gateway_multicast_domain_association.test.transit_gateway_multicast_domain_id
+}
+`)
+}
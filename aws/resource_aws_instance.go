@@ -2,6 +2,7 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/hex"
@@ -16,12 +17,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
 	tfiam "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam"
 	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
@@ -50,9 +53,11 @@ func resourceAwsInstance() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"ami": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"ami", "launch_template"},
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -163,6 +168,11 @@ func resourceAwsInstance() *schema.Resource {
 							ForceNew: true,
 						},
 						"tags": tagsSchemaConflictsWith([]string{"volume_tags"}),
+						"tags_all": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 						"throughput": {
 							Type:             schema.TypeInt,
 							Optional:         true,
@@ -200,6 +210,7 @@ func resourceAwsInstance() *schema.Resource {
 			"ebs_optimized": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"enclave_options": {
@@ -258,6 +269,7 @@ func resourceAwsInstance() *schema.Resource {
 			"hibernation": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"host_id": {
@@ -280,8 +292,10 @@ func resourceAwsInstance() *schema.Resource {
 				Computed: true,
 			},
 			"instance_type": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"instance_type", "launch_template"},
 			},
 			"ipv6_address_count": {
 				Type:     schema.TypeInt,
@@ -305,6 +319,39 @@ func resourceAwsInstance() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"launch_template": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"launch_template.0.id", "launch_template.0.name"},
+							ValidateFunc: validateLaunchTemplateId,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"launch_template.0.id", "launch_template.0.name"},
+							ValidateFunc: validateLaunchTemplateName,
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      tfec2.LaunchTemplateVersionDefault,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
 			"metadata_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -442,6 +489,11 @@ func resourceAwsInstance() *schema.Resource {
 							ForceNew: true,
 						},
 						"tags": tagsSchemaConflictsWith([]string{"volume_tags"}),
+						"tags_all": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 						"throughput": {
 							Type:             schema.TypeInt,
 							Optional:         true,
@@ -551,7 +603,8 @@ func resourceAwsInstance() *schema.Resource {
 					return
 				},
 			},
-			"volume_tags": tagsSchema(),
+			"volume_tags":     tagsSchema(),
+			"volume_tags_all": tagsSchemaComputed(),
 			"vpc_security_group_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -592,10 +645,79 @@ func resourceAwsInstance() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			instanceVolumeTagsAllDiff,
+			instanceRootBlockDeviceTagsAllDiff,
+		),
 	}
 }
 
+// instanceVolumeTagsAllDiff sets the new plan difference for volume_tags_all with the result of
+// merging volume_tags on to those defined at the provider-level, so that changes to the provider
+// default_tags configuration block are applied to the instance volumes.
+func instanceVolumeTagsAllDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	volumeTags := diff.Get("volume_tags").(map[string]interface{})
+
+	if len(volumeTags) == 0 && len(diff.Get("volume_tags_all").(map[string]interface{})) == 0 {
+		return nil
+	}
+
+	if !diff.NewValueKnown("volume_tags") {
+		if err := diff.SetNewComputed("volume_tags_all"); err != nil {
+			return fmt.Errorf("error setting volume_tags_all to computed: %w", err)
+		}
+
+		return nil
+	}
+
+	allTags := ec2SubResourceTags(volumeTags, meta.(*AWSClient).DefaultTagsConfig, meta.(*AWSClient).IgnoreTagsConfig)
+
+	if err := diff.SetNew("volume_tags_all", allTags.Map()); err != nil {
+		return fmt.Errorf("error setting new volume_tags_all diff: %w", err)
+	}
+
+	return nil
+}
+
+// instanceRootBlockDeviceTagsAllDiff sets the new plan difference for root_block_device.0.tags_all with the
+// result of merging root_block_device.0.tags on to those defined at the provider-level, so that changes to the
+// provider default_tags configuration block are applied to the root volume.
+// Nested attributes cannot be set individually, so the difference is only set when the root_block_device
+// configuration is otherwise unchanged; configuration changes update the root volume tags regardless.
+func instanceRootBlockDeviceTagsAllDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.HasChange("root_block_device") || len(diff.Get("volume_tags").(map[string]interface{})) > 0 {
+		return nil
+	}
+
+	v, ok := diff.Get("root_block_device").([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	for k, v := range v[0].(map[string]interface{}) {
+		tfMap[k] = v
+	}
+
+	allTags := ec2SubResourceTags(tfMap["tags"].(map[string]interface{}), meta.(*AWSClient).DefaultTagsConfig, meta.(*AWSClient).IgnoreTagsConfig)
+	oldTags := keyvaluetags.New(tfMap["tags_all"].(map[string]interface{}))
+
+	if oldTags.ContainsAll(allTags) && allTags.ContainsAll(oldTags) {
+		return nil
+	}
+
+	tfMap["tags_all"] = allTags.Map()
+
+	if err := diff.SetNew("root_block_device", []interface{}{tfMap}); err != nil {
+		return fmt.Errorf("error setting new root_block_device.0.tags_all diff: %w", err)
+	}
+
+	return nil
+}
+
 func iopsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	// Suppress diff if volume_type is not io1, io2, or gp3 and iops is unset or configured as 0
 	i := strings.LastIndexByte(k, '.')
@@ -615,6 +737,7 @@ func throughputDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	instanceOpts, err := buildAwsInstanceOpts(d, meta)
@@ -623,7 +746,12 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	tagSpecifications := ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeInstance)
-	tagSpecifications = append(tagSpecifications, ec2TagSpecificationsFromMap(d.Get("volume_tags").(map[string]interface{}), ec2.ResourceTypeVolume)...)
+	tagSpecifications = append(tagSpecifications, ec2TagSpecificationsFromKeyValueTags(ec2SubResourceTags(d.Get("volume_tags").(map[string]interface{}), defaultTagsConfig, ignoreTagsConfig), ec2.ResourceTypeVolume)...)
+
+	// Network interfaces configured in network_interface are attached to, not created with, the instance.
+	if _, ok := d.GetOk("network_interface"); !ok {
+		tagSpecifications = append(tagSpecifications, ec2TagSpecificationsFromKeyValueTags(ec2SubResourceTags(nil, defaultTagsConfig, ignoreTagsConfig), ec2.ResourceTypeNetworkInterface)...)
+	}
 
 	// Build the creation struct
 	runOpts := &ec2.RunInstancesInput{
@@ -639,6 +767,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		Ipv6AddressCount:                  instanceOpts.Ipv6AddressCount,
 		Ipv6Addresses:                     instanceOpts.Ipv6Addresses,
 		KeyName:                           instanceOpts.KeyName,
+		LaunchTemplate:                    instanceOpts.LaunchTemplate,
 		MaxCount:                          aws.Int64(int64(1)),
 		MinCount:                          aws.Int64(int64(1)),
 		NetworkInterfaces:                 instanceOpts.NetworkInterfaces,
@@ -846,6 +975,19 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting enclave_options: %s", err)
 	}
 
+	// Checked before launch_template is set so that all attributes are read on import.
+	_, hasLaunchTemplate := d.GetOk("launch_template")
+
+	launchTemplate, err := readInstanceLaunchTemplate(d, instance, conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance (%s) launch template: %w", d.Id(), err)
+	}
+
+	if err := d.Set("launch_template", launchTemplate); err != nil {
+		return fmt.Errorf("error setting launch_template: %w", err)
+	}
+
 	d.Set("ami", instance.ImageId)
 	d.Set("instance_type", instance.InstanceType)
 	d.Set("key_name", instance.KeyName)
@@ -855,7 +997,9 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("private_ip", instance.PrivateIpAddress)
 	d.Set("outpost_arn", instance.OutpostArn)
 
-	if instance.IamInstanceProfile != nil && instance.IamInstanceProfile.Arn != nil {
+	if skipInstanceLaunchTemplateAttribute(d, hasLaunchTemplate, "iam_instance_profile") {
+		log.Printf("[DEBUG] Not setting iam_instance_profile for EC2 Instance (%s) launched from a launch template", d.Id())
+	} else if instance.IamInstanceProfile != nil && instance.IamInstanceProfile.Arn != nil {
 		name, err := tfiam.InstanceProfileARNToName(aws.StringValue(instance.IamInstanceProfile.Arn))
 
 		if err != nil {
@@ -955,7 +1099,7 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("source_dest_check", instance.SourceDestCheck)
 	}
 
-	if instance.Monitoring != nil && instance.Monitoring.State != nil {
+	if instance.Monitoring != nil && instance.Monitoring.State != nil && !skipInstanceLaunchTemplateAttribute(d, hasLaunchTemplate, "monitoring") {
 		monitoringState := aws.StringValue(instance.Monitoring.State)
 		d.Set("monitoring", monitoringState == ec2.MonitoringStateEnabled || monitoringState == ec2.MonitoringStatePending)
 	}
@@ -977,9 +1121,15 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		if err := d.Set("volume_tags", keyvaluetags.Ec2KeyValueTags(volumeTags).IgnoreAws().Map()); err != nil {
+		tags := keyvaluetags.Ec2KeyValueTags(volumeTags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+		if err := d.Set("volume_tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 			return fmt.Errorf("error setting volume_tags: %s", err)
 		}
+
+		if err := d.Set("volume_tags_all", tags.Map()); err != nil {
+			return fmt.Errorf("error setting volume_tags_all: %s", err)
+		}
 	}
 
 	if err := readSecurityGroups(d, instance, conn); err != nil {
@@ -991,7 +1141,7 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := readBlockDevices(d, instance, conn); err != nil {
+	if err := readInstanceBlockDevices(d, instance, conn, defaultTagsConfig, ignoreTagsConfig); err != nil {
 		return err
 	}
 	if _, ok := d.GetOk("ephemeral_block_device"); !ok {
@@ -1018,7 +1168,9 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		d.Set("disable_api_termination", attr.DisableApiTermination.Value)
+		if !skipInstanceLaunchTemplateAttribute(d, hasLaunchTemplate, "disable_api_termination") {
+			d.Set("disable_api_termination", attr.DisableApiTermination.Value)
+		}
 	}
 	{
 		attr, err := conn.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
@@ -1036,7 +1188,7 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 			_, b64 := d.GetOk("user_data_base64")
 			if b64 {
 				d.Set("user_data_base64", attr.UserData.Value)
-			} else {
+			} else if !skipInstanceLaunchTemplateAttribute(d, hasLaunchTemplate, "user_data") {
 				d.Set("user_data", userDataHashSum(aws.StringValue(attr.UserData.Value)))
 			}
 		}
@@ -1078,6 +1230,8 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	if d.HasChange("tags_all") && !d.IsNewResource() {
 		o, n := d.GetChange("tags_all")
@@ -1087,16 +1241,20 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("volume_tags") && !d.IsNewResource() {
+	if d.HasChanges("volume_tags", "volume_tags_all") && !d.IsNewResource() {
 		volumeIds, err := getAwsInstanceVolumeIds(conn, d.Id())
 		if err != nil {
 			return err
 		}
 
+		// The previously applied tags include any provider default tags since removed from the configuration.
 		o, n := d.GetChange("volume_tags")
+		oAll, _ := d.GetChange("volume_tags_all")
+		oldTags := ec2SubResourceTags(o.(map[string]interface{}), defaultTagsConfig, ignoreTagsConfig).Merge(keyvaluetags.New(oAll.(map[string]interface{})))
+		newTags := ec2SubResourceTags(n.(map[string]interface{}), defaultTagsConfig, ignoreTagsConfig)
 
 		for _, volumeId := range volumeIds {
-			if err := keyvaluetags.Ec2UpdateTags(conn, volumeId, o, n); err != nil {
+			if err := keyvaluetags.Ec2UpdateTags(conn, volumeId, oldTags, newTags); err != nil {
 				return fmt.Errorf("error updating volume_tags (%s): %s", volumeId, err)
 			}
 		}
@@ -1569,10 +1727,14 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		if d.HasChange("root_block_device.0.tags") {
+		if d.HasChanges("root_block_device.0.tags", "root_block_device.0.tags_all") {
+			// The previously applied tags include any provider default tags since removed from the configuration.
 			o, n := d.GetChange("root_block_device.0.tags")
+			oAll, _ := d.GetChange("root_block_device.0.tags_all")
+			oldTags := ec2SubResourceTags(o.(map[string]interface{}), defaultTagsConfig, ignoreTagsConfig).Merge(keyvaluetags.New(oAll.(map[string]interface{})))
+			newTags := ec2SubResourceTags(n.(map[string]interface{}), defaultTagsConfig, ignoreTagsConfig)
 
-			if err := keyvaluetags.Ec2UpdateTags(conn, volumeID, o, n); err != nil {
+			if err := keyvaluetags.Ec2UpdateTags(conn, volumeID, oldTags, newTags); err != nil {
 				return fmt.Errorf("error updating tags for volume (%s): %s", volumeID, err)
 			}
 		}
@@ -1774,6 +1936,36 @@ func readBlockDevices(d *schema.ResourceData, instance *ec2.Instance, conn *ec2.
 		return err
 	}
 
+	return setBlockDevices(d, ibds)
+}
+
+// readInstanceBlockDevices reads the block devices of an instance resource, setting tags_all for
+// the root and EBS block devices and removing the provider default tags from their tags.
+func readInstanceBlockDevices(d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2, defaultTagsConfig *keyvaluetags.DefaultConfig, ignoreTagsConfig *keyvaluetags.IgnoreConfig) error {
+	ibds, err := readBlockDevicesFromInstance(d, instance, conn)
+	if err != nil {
+		return err
+	}
+
+	bds, _ := ibds["ebs"].([]map[string]interface{})
+
+	if bd, ok := ibds["root"].(map[string]interface{}); ok {
+		bds = append(bds, bd)
+	}
+
+	for _, bd := range bds {
+		if v, ok := bd["tags"].(map[string]string); ok {
+			tags := keyvaluetags.New(v).IgnoreConfig(ignoreTagsConfig)
+
+			bd["tags"] = tags.RemoveDefaultConfig(defaultTagsConfig).Map()
+			bd["tags_all"] = tags.Map()
+		}
+	}
+
+	return setBlockDevices(d, ibds)
+}
+
+func setBlockDevices(d *schema.ResourceData, ibds map[string]interface{}) error {
 	// This handles cases where the root device block is of type "EBS"
 	// and #readBlockDevicesFromInstance only returns 1 reference to a block-device
 	// stored in ibds["root"]
@@ -2190,11 +2382,28 @@ func readBlockDeviceMappingsFromConfig(d *schema.ResourceData, conn *ec2.EC2) ([
 				}
 			}
 
-			if dn, err := fetchRootDeviceName(d.Get("ami").(string), conn); err == nil {
+			ami := d.Get("ami").(string)
+
+			// When launching from a launch template without an AMI configured, the root device is that of the template's AMI.
+			if v, ok := d.GetOk("launch_template"); ok && ami == "" && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				tfMap := v.([]interface{})[0].(map[string]interface{})
+
+				launchTemplateVersion, err := finder.LaunchTemplateVersion(conn, tfMap["id"].(string), tfMap["name"].(string), tfMap["version"].(string))
+
+				if err != nil {
+					return nil, fmt.Errorf("error reading EC2 Launch Template version: %w", err)
+				}
+
+				if launchTemplateVersion.LaunchTemplateData != nil {
+					ami = aws.StringValue(launchTemplateVersion.LaunchTemplateData.ImageId)
+				}
+			}
+
+			if dn, err := fetchRootDeviceName(ami, conn); err == nil {
 				if dn == nil {
 					return nil, fmt.Errorf(
 						"Expected 1 AMI for ID: %s, got none",
-						d.Get("ami").(string))
+						ami)
 				}
 
 				blockDevices = append(blockDevices, &ec2.BlockDeviceMapping{
@@ -2210,6 +2419,90 @@ func readBlockDeviceMappingsFromConfig(d *schema.ResourceData, conn *ec2.EC2) ([
 	return blockDevices, nil
 }
 
+// skipInstanceLaunchTemplateAttribute returns whether Read leaves an attribute that the launch template
+// can provide unset. Values provided by the launch template are not tracked until the attribute is configured,
+// so that they cause no difference while removing a configured attribute still turns it off.
+func skipInstanceLaunchTemplateAttribute(d *schema.ResourceData, hasLaunchTemplate bool, k string) bool {
+	if !hasLaunchTemplate {
+		return false
+	}
+
+	_, ok := d.GetOk(k)
+
+	return !ok
+}
+
+// readInstanceLaunchTemplate returns the launch_template of an instance launched from a launch template.
+// A $Default or $Latest version is kept while it still resolves to the version the instance was launched with;
+// otherwise the launched version is returned so that the difference from the configuration forces a new instance.
+func readInstanceLaunchTemplate(d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) ([]interface{}, error) {
+	var launchTemplateID, instanceVersion string
+
+	for _, tag := range instance.Tags {
+		switch aws.StringValue(tag.Key) {
+		case "aws:ec2launchtemplate:id":
+			launchTemplateID = aws.StringValue(tag.Value)
+		case "aws:ec2launchtemplate:version":
+			instanceVersion = aws.StringValue(tag.Value)
+		}
+	}
+
+	if launchTemplateID == "" {
+		return nil, nil
+	}
+
+	version := d.Get("launch_template.0.version").(string)
+
+	// The version is unknown on import.
+	if version == "" {
+		version = tfec2.LaunchTemplateVersionDefault
+	}
+
+	tfMap := map[string]interface{}{
+		"id":      launchTemplateID,
+		"name":    d.Get("launch_template.0.name").(string),
+		"version": instanceVersion,
+	}
+
+	launchTemplate, err := finder.LaunchTemplateByID(conn, launchTemplateID)
+
+	// The launch template can be deleted independently of the instance.
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Launch Template (%s) not found, unable to detect version changes", launchTemplateID)
+
+		if version == tfec2.LaunchTemplateVersionDefault || version == tfec2.LaunchTemplateVersionLatest {
+			tfMap["version"] = version
+		}
+
+		return []interface{}{tfMap}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	tfMap["name"] = aws.StringValue(launchTemplate.LaunchTemplateName)
+
+	var templateVersion string
+
+	switch version {
+	case tfec2.LaunchTemplateVersionDefault:
+		templateVersion = strconv.FormatInt(aws.Int64Value(launchTemplate.DefaultVersionNumber), 10)
+	case tfec2.LaunchTemplateVersionLatest:
+		templateVersion = strconv.FormatInt(aws.Int64Value(launchTemplate.LatestVersionNumber), 10)
+	}
+
+	if templateVersion != "" {
+		if templateVersion == instanceVersion {
+			tfMap["version"] = version
+		} else {
+			log.Printf("[DEBUG] EC2 Instance (%s) launched with EC2 Launch Template (%s) version %s, %s is now version %s", d.Id(), launchTemplateID, instanceVersion, version, templateVersion)
+		}
+	}
+
+	return []interface{}{tfMap}, nil
+}
+
 func readVolumeTags(conn *ec2.EC2, instanceId string) ([]*ec2.Tag, error) {
 	volumeIds, err := getAwsInstanceVolumeIds(conn, instanceId)
 	if err != nil {
@@ -2359,6 +2652,7 @@ type awsInstanceOpts struct {
 	Ipv6AddressCount                  *int64
 	Ipv6Addresses                     []*ec2.InstanceIpv6Address
 	KeyName                           *string
+	LaunchTemplate                    *ec2.LaunchTemplateSpecification
 	NetworkInterfaces                 []*ec2.InstanceNetworkInterfaceSpecification
 	Placement                         *ec2.Placement
 	PrivateIPAddress                  *string
@@ -2379,12 +2673,30 @@ func buildAwsInstanceOpts(d *schema.ResourceData, meta interface{}) (*awsInstanc
 
 	instanceType := d.Get("instance_type").(string)
 	opts := &awsInstanceOpts{
-		DisableAPITermination: aws.Bool(d.Get("disable_api_termination").(bool)),
-		EBSOptimized:          aws.Bool(d.Get("ebs_optimized").(bool)),
-		ImageID:               aws.String(d.Get("ami").(string)),
-		InstanceType:          aws.String(instanceType),
-		MetadataOptions:       expandEc2InstanceMetadataOptions(d.Get("metadata_options").([]interface{})),
-		EnclaveOptions:        expandEc2EnclaveOptions(d.Get("enclave_options").([]interface{})),
+		MetadataOptions: expandEc2InstanceMetadataOptions(d.Get("metadata_options").([]interface{})),
+		EnclaveOptions:  expandEc2EnclaveOptions(d.Get("enclave_options").([]interface{})),
+	}
+
+	// Attributes that can be provided by a launch template are only sent when configured,
+	// as any value sent in the request overrides the launch template.
+	if v, ok := d.GetOk("ami"); ok {
+		opts.ImageID = aws.String(v.(string))
+	}
+
+	if instanceType != "" {
+		opts.InstanceType = aws.String(instanceType)
+	}
+
+	if v, ok := d.GetOkExists("disable_api_termination"); ok {
+		opts.DisableAPITermination = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("ebs_optimized"); ok {
+		opts.EBSOptimized = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("launch_template"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		opts.LaunchTemplate = expandEc2LaunchTemplateSpecification(v.([]interface{})[0].(map[string]interface{}))
 	}
 
 	// Set default cpu_credits as Unlimited for T3 instance type
@@ -2413,12 +2725,16 @@ func buildAwsInstanceOpts(d *schema.ResourceData, meta interface{}) (*awsInstanc
 		opts.InstanceInitiatedShutdownBehavior = aws.String(v)
 	}
 
-	opts.Monitoring = &ec2.RunInstancesMonitoringEnabled{
-		Enabled: aws.Bool(d.Get("monitoring").(bool)),
+	if v, ok := d.GetOkExists("monitoring"); ok {
+		opts.Monitoring = &ec2.RunInstancesMonitoringEnabled{
+			Enabled: aws.Bool(v.(bool)),
+		}
 	}
 
-	opts.IAMInstanceProfile = &ec2.IamInstanceProfileSpecification{
-		Name: aws.String(d.Get("iam_instance_profile").(string)),
+	if v, ok := d.GetOk("iam_instance_profile"); ok {
+		opts.IAMInstanceProfile = &ec2.IamInstanceProfileSpecification{
+			Name: aws.String(v.(string)),
+		}
 	}
 
 	userData := d.Get("user_data").(string)
@@ -2436,14 +2752,17 @@ func buildAwsInstanceOpts(d *schema.ResourceData, meta interface{}) (*awsInstanc
 
 	// Placement is used for aws_instance; SpotPlacement is used for
 	// aws_spot_instance_request. They represent the same data. :-|
-	opts.Placement = &ec2.Placement{
-		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
-		GroupName:        aws.String(d.Get("placement_group").(string)),
+	opts.Placement = &ec2.Placement{}
+	opts.SpotPlacement = &ec2.SpotPlacement{}
+
+	if v := d.Get("availability_zone").(string); v != "" {
+		opts.Placement.AvailabilityZone = aws.String(v)
+		opts.SpotPlacement.AvailabilityZone = aws.String(v)
 	}
 
-	opts.SpotPlacement = &ec2.SpotPlacement{
-		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
-		GroupName:        aws.String(d.Get("placement_group").(string)),
+	if v := d.Get("placement_group").(string); v != "" {
+		opts.Placement.GroupName = aws.String(v)
+		opts.SpotPlacement.GroupName = aws.String(v)
 	}
 
 	if v := d.Get("tenancy").(string); v != "" {
@@ -2464,7 +2783,7 @@ func buildAwsInstanceOpts(d *schema.ResourceData, meta interface{}) (*awsInstanc
 		}
 	}
 
-	if v := d.Get("hibernation"); v != "" {
+	if v, ok := d.GetOk("hibernation"); ok {
 		opts.HibernationOptions = &ec2.HibernationOptionsRequest{
 			Configured: aws.Bool(v.(bool)),
 		}
@@ -2709,6 +3028,27 @@ func getCreditSpecifications(conn *ec2.EC2, instanceId string) ([]map[string]int
 	return creditSpecifications, nil
 }
 
+func expandEc2LaunchTemplateSpecification(tfMap map[string]interface{}) *ec2.LaunchTemplateSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.LaunchTemplateSpecification{}
+
+	// The launch template ID and name are both set in state, but only one of them can be sent.
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.LaunchTemplateId = aws.String(v)
+	} else if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.LaunchTemplateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandEc2InstanceMetadataOptions(l []interface{}) *ec2.InstanceMetadataOptionsRequest {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1140,6 +1140,53 @@ func TestAccAWSInstance_blockDeviceTags_volumeTags(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_blockDeviceTags_defaultTags(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(
+					testAccInstanceConfigProviderDefaultTags("defaultkey1", "defaultvalue1"),
+					testAccInstanceConfigBlockDeviceTagsVolumeTags(),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "volume_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "volume_tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "volume_tags_all.Name", "acceptance-test-volume-tag"),
+					resource.TestCheckResourceAttr(resourceName, "volume_tags_all.defaultkey1", "defaultvalue1"),
+				),
+			},
+			{
+				Config: composeConfig(
+					testAccInstanceConfigProviderDefaultTags("defaultkey2", "defaultvalue2"),
+					testAccInstanceConfigBlockDeviceTagsVolumeTags(),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "volume_tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "volume_tags_all.Name", "acceptance-test-volume-tag"),
+					resource.TestCheckResourceAttr(resourceName, "volume_tags_all.defaultkey2", "defaultvalue2"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigBlockDeviceTagsVolumeTags(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "volume_tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "volume_tags_all.Name", "acceptance-test-volume-tag"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_blockDeviceTags_withAttachedVolume(t *testing.T) {
 	var v ec2.Instance
 	resourceName := "aws_instance.test"
@@ -3587,6 +3634,247 @@ func TestAccAWSInstance_CapacityReservation_modifyTarget(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_LaunchTemplate_basic(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+	launchTemplateResourceName := "aws_launch_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigLaunchTemplateId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.id", launchTemplateResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.name", launchTemplateResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.0.version", "$Default"),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", launchTemplateResourceName, "image_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type", launchTemplateResourceName, "instance_type"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_LaunchTemplate_name(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+	launchTemplateResourceName := "aws_launch_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigLaunchTemplateName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.id", launchTemplateResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.name", launchTemplateResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.0.version", "$Default"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_LaunchTemplate_overrideTemplate(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigLaunchTemplateOverrideTemplate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ami.amzn-ami-minimal-hvm-ebs", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type", "data.aws_ec2_instance_type_offering.available", "instance_type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_LaunchTemplate_setSpecificVersion(t *testing.T) {
+	var v1, v2 ec2.Instance
+	resourceName := "aws_instance.test"
+	launchTemplateResourceName := "aws_launch_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigLaunchTemplateSpecificVersion(rName, "t2.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.version", launchTemplateResourceName, "latest_version"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.micro"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigLaunchTemplateSpecificVersion(rName, "t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.version", launchTemplateResourceName, "latest_version"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t3.micro"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_LaunchTemplate_modifyTemplate_defaultVersion(t *testing.T) {
+	var v1, v2 ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigLaunchTemplateModifyTemplate(rName, "t2.micro", "$Default", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.0.version", "$Default"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.micro"),
+				),
+			},
+			// The new default version is only detected once the launch template has been updated.
+			{
+				Config:             testAccInstanceConfigLaunchTemplateModifyTemplate(rName, "t3.micro", "$Default", true),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccInstanceConfigLaunchTemplateModifyTemplate(rName, "t3.micro", "$Default", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.0.version", "$Default"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t3.micro"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_LaunchTemplate_modifyTemplate_latestVersion(t *testing.T) {
+	var v1, v2 ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigLaunchTemplateModifyTemplate(rName, "t2.micro", "$Latest", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.0.version", "$Latest"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.micro"),
+				),
+			},
+			// The new latest version is only detected once the launch template has been updated.
+			{
+				Config:             testAccInstanceConfigLaunchTemplateModifyTemplate(rName, "t3.micro", "$Latest", false),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccInstanceConfigLaunchTemplateModifyTemplate(rName, "t3.micro", "$Latest", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.0.version", "$Latest"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t3.micro"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_LaunchTemplate_monitoring(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigLaunchTemplateMonitoring(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					testAccCheckInstanceMonitoring(&v, false),
+					resource.TestCheckResourceAttr(resourceName, "monitoring", "false"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigLaunchTemplateMonitoring(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					testAccCheckInstanceMonitoring(&v, true),
+					resource.TestCheckResourceAttr(resourceName, "monitoring", "true"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigLaunchTemplateMonitoringRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					testAccCheckInstanceMonitoring(&v, false),
+					resource.TestCheckResourceAttr(resourceName, "monitoring", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceMonitoring(instance *ec2.Instance, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		state := aws.StringValue(instance.Monitoring.State)
+
+		if got := state == ec2.MonitoringStateEnabled || state == ec2.MonitoringStatePending; got != enabled {
+			return fmt.Errorf("EC2 Instance (%s) monitoring state: %s", aws.StringValue(instance.InstanceId), state)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckInstanceNotRecreated(before, after *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if before, after := aws.StringValue(before.InstanceId), aws.StringValue(after.InstanceId); before != after {
@@ -4780,6 +5068,19 @@ resource "aws_instance" "test" {
 }
 `)
 
+func testAccInstanceConfigProviderDefaultTags(key1, value1 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+    }
+  }
+}
+`, key1, value1)
+}
+
 func testAccInstanceConfigBlockDeviceTagsVolumeTags() string {
 	return composeConfig(testAccLatestAmazonLinuxHvmEbsAmiConfig(), `
 resource "aws_instance" "test" {
@@ -6258,3 +6559,179 @@ resource "aws_ec2_capacity_reservation" "test" {
 }
 `, rName, ec2.CapacityReservationInstancePlatformLinuxUnix))
 }
+
+func testAccInstanceConfigLaunchTemplateId(rName string) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		testAccAvailableEc2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+}
+
+resource "aws_instance" "test" {
+  launch_template {
+    id = aws_launch_template.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccInstanceConfigLaunchTemplateName(rName string) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		testAccAvailableEc2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+}
+
+resource "aws_instance" "test" {
+  launch_template {
+    name = aws_launch_template.test.name
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccInstanceConfigLaunchTemplateOverrideTemplate(rName string) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		testAccLatestAmazonNatInstanceAmiConfig(),
+		testAccAvailableEc2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-nat-instance.id
+  instance_type = "t2.nano"
+}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  launch_template {
+    id = aws_launch_template.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccInstanceConfigLaunchTemplateSpecificVersion(rName, instanceType string) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = %[2]q
+}
+
+resource "aws_instance" "test" {
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.latest_version
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType))
+}
+
+func testAccInstanceConfigLaunchTemplateModifyTemplate(rName, instanceType, version string, updateDefaultVersion bool) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name                   = %[1]q
+  image_id               = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type          = %[2]q
+  update_default_version = %[4]t
+}
+
+resource "aws_instance" "test" {
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = %[3]q
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType, version, updateDefaultVersion))
+}
+
+func testAccInstanceConfigLaunchTemplateMonitoring(rName string, monitoring bool) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		testAccAvailableEc2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  monitoring {
+    enabled = true
+  }
+}
+
+resource "aws_instance" "test" {
+  monitoring = %[2]t
+
+  launch_template {
+    id = aws_launch_template.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, monitoring))
+}
+
+func testAccInstanceConfigLaunchTemplateMonitoringRemoved(rName string) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		testAccAvailableEc2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  monitoring {
+    enabled = true
+  }
+}
+
+resource "aws_instance" "test" {
+  launch_template {
+    id = aws_launch_template.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
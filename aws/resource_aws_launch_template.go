@@ -602,6 +602,7 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 								ec2.ResourceTypeVolume,
 								ec2.ResourceTypeSpotInstancesRequest,
 								ec2.ResourceTypeElasticGpu,
+								ec2.ResourceTypeNetworkInterface,
 							}, false),
 						},
 						"tags": tagsSchema(),
@@ -609,6 +610,12 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 				},
 			},
 
+			"propagated_default_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"user_data": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -633,6 +640,7 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 		// Enable downstream updates for resources referencing schema attributes
 		// to prevent non-empty plans after "terraform apply"
 		CustomizeDiff: customdiff.Sequence(
+			launchTemplatePropagatedDefaultTagsDiff,
 			customdiff.ComputedIf("default_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
@@ -855,7 +863,33 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error setting hibernation_options: %s", err)
 	}
 
-	if err := d.Set("tag_specifications", getTagSpecifications(ltData.TagSpecifications)); err != nil {
+	// Previously propagated default tags are included so that keys since removed
+	// from the provider default_tags configuration block are removed on update.
+	configuredTags := launchTemplateConfiguredTags(d.Get("tag_specifications").([]interface{}))
+	defaultTagKeys := keyvaluetags.New(d.Get("propagated_default_tags").(map[string]interface{})).Merge(defaultTagsConfig.LaunchedInstanceTags())
+	propagatedDefaultTags := make(keyvaluetags.KeyValueTags)
+
+	for _, v := range ltData.TagSpecifications {
+		resourceType := aws.StringValue(v.ResourceType)
+
+		if !launchTemplateDefaultTagsResourceType(resourceType) {
+			continue
+		}
+
+		tags := keyvaluetags.Ec2KeyValueTags(v.Tags)
+
+		for _, key := range defaultTagKeys.Removed(configuredTags[resourceType]).Keys() {
+			if _, ok := propagatedDefaultTags[key]; !ok && tags.KeyExists(key) {
+				propagatedDefaultTags[key] = tags.KeyTagData(key)
+			}
+		}
+	}
+
+	if err := d.Set("propagated_default_tags", propagatedDefaultTags.Map()); err != nil {
+		return fmt.Errorf("error setting propagated_default_tags: %w", err)
+	}
+
+	if err := d.Set("tag_specifications", getTagSpecificationsWithoutDefaultTags(ltData.TagSpecifications, configuredTags, propagatedDefaultTags)); err != nil {
 		return fmt.Errorf("error setting tag_specifications: %s", err)
 	}
 
@@ -1300,6 +1334,104 @@ func getTagSpecifications(t []*ec2.LaunchTemplateTagSpecification) []interface{}
 	return s
 }
 
+// getTagSpecificationsWithoutDefaultTags flattens the tag specifications, removing the provider default tags
+// propagated to launched instances and volumes unless they are also configured in the resource.
+func getTagSpecificationsWithoutDefaultTags(t []*ec2.LaunchTemplateTagSpecification, configuredTags map[string]keyvaluetags.KeyValueTags, defaultTags keyvaluetags.KeyValueTags) []interface{} {
+	if len(defaultTags) == 0 {
+		return getTagSpecifications(t)
+	}
+
+	var s []interface{}
+	for _, v := range t {
+		resourceType := aws.StringValue(v.ResourceType)
+		tags := keyvaluetags.Ec2KeyValueTags(v.Tags).IgnoreAws()
+
+		if launchTemplateDefaultTagsResourceType(resourceType) {
+			configured, ok := configuredTags[resourceType]
+			tags = tags.RemoveDefaultConfig(&keyvaluetags.DefaultConfig{
+				Tags: defaultTags.Removed(configured),
+			})
+
+			if len(tags) == 0 && !ok {
+				continue
+			}
+		}
+
+		s = append(s, map[string]interface{}{
+			"resource_type": resourceType,
+			"tags":          tags.Map(),
+		})
+	}
+	return s
+}
+
+// launchTemplateConfiguredTags returns the tags configured in the tag_specifications argument by resource type.
+func launchTemplateConfiguredTags(tagSpecifications []interface{}) map[string]keyvaluetags.KeyValueTags {
+	configuredTags := make(map[string]keyvaluetags.KeyValueTags)
+
+	for _, ts := range tagSpecifications {
+		tsData, ok := ts.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		resourceType := tsData["resource_type"].(string)
+		configuredTags[resourceType] = configuredTags[resourceType].Merge(keyvaluetags.New(tsData["tags"].(map[string]interface{})))
+	}
+
+	return configuredTags
+}
+
+// launchTemplatePropagatedDefaultTagsDiff plans the provider default tags to propagate to launched instances,
+// excluding those overridden in the tag specifications of every propagated resource type, so that changes to
+// the provider default_tags configuration block create a new Launch Template version.
+func launchTemplatePropagatedDefaultTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	configuredTags := launchTemplateConfiguredTags(diff.Get("tag_specifications").([]interface{}))
+	defaultTags := make(keyvaluetags.KeyValueTags)
+
+	for key, value := range meta.(*AWSClient).DefaultTagsConfig.LaunchedInstanceTags().IgnoreAws() {
+		for _, resourceType := range launchTemplateDefaultTagsResourceTypes {
+			if !configuredTags[resourceType].KeyExists(key) {
+				defaultTags[key] = value
+				break
+			}
+		}
+	}
+
+	propagatedDefaultTags := keyvaluetags.New(diff.Get("propagated_default_tags").(map[string]interface{}))
+
+	if diff.Id() != "" && propagatedDefaultTags.ContainsAll(defaultTags) && defaultTags.ContainsAll(propagatedDefaultTags) {
+		return nil
+	}
+
+	if err := diff.SetNew("propagated_default_tags", defaultTags.Map()); err != nil {
+		return fmt.Errorf("error setting new propagated_default_tags diff: %w", err)
+	}
+
+	return nil
+}
+
+// launchTemplateDefaultTagsResourceTypes are the tag specification resource types to which
+// provider default tags are propagated.
+var launchTemplateDefaultTagsResourceTypes = []string{
+	ec2.ResourceTypeInstance,
+	ec2.ResourceTypeNetworkInterface,
+	ec2.ResourceTypeVolume,
+}
+
+// launchTemplateDefaultTagsResourceType returns whether provider default tags are propagated
+// to the tag specification resource type.
+func launchTemplateDefaultTagsResourceType(resourceType string) bool {
+	for _, v := range launchTemplateDefaultTagsResourceTypes {
+		if v == resourceType {
+			return true
+		}
+	}
+
+	return false
+}
+
 func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplateData, error) {
 	opts := &ec2.RequestLaunchTemplateData{
 		UserData: aws.String(d.Get("user_data").(string)),
@@ -1512,6 +1644,29 @@ func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplate
 		opts.TagSpecifications = tagSpecifications
 	}
 
+	if defaultTags := keyvaluetags.New(d.Get("propagated_default_tags").(map[string]interface{})); len(defaultTags) > 0 {
+		for _, resourceType := range launchTemplateDefaultTagsResourceTypes {
+			var tagSpecification *ec2.LaunchTemplateTagSpecificationRequest
+
+			for _, v := range opts.TagSpecifications {
+				if aws.StringValue(v.ResourceType) == resourceType {
+					tagSpecification = v
+					break
+				}
+			}
+
+			if tagSpecification == nil {
+				opts.TagSpecifications = append(opts.TagSpecifications, &ec2.LaunchTemplateTagSpecificationRequest{
+					ResourceType: aws.String(resourceType),
+					Tags:         defaultTags.Ec2Tags(),
+				})
+				continue
+			}
+
+			tagSpecification.Tags = defaultTags.Merge(keyvaluetags.Ec2KeyValueTags(tagSpecification.Tags)).Ec2Tags()
+		}
+	}
+
 	return opts, nil
 }
 
@@ -1898,6 +2053,7 @@ var updateKeys = []string{
 	"monitoring",
 	"network_interfaces",
 	"placement",
+	"propagated_default_tags",
 	"ram_disk_id",
 	"security_group_names",
 	"tag_specifications",
@@ -64,7 +64,8 @@ func resourceAwsS3AccessPoint() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				Computed:         true,
+				DiffSuppressFunc: suppressEquivalentS3AccessPointPolicyDiffs,
 			},
 			"public_access_block_configuration": {
 				Type:             schema.TypeList,
@@ -158,7 +159,7 @@ func resourceAwsS3AccessPointCreate(d *schema.ResourceData, meta interface{}) er
 		d.SetId(fmt.Sprintf("%s:%s", accountId, name))
 	}
 
-	if v, ok := d.GetOk("policy"); ok {
+	if v, ok := d.GetOk("policy"); ok && v.(string) != "{}" {
 		log.Printf("[DEBUG] Putting S3 Access Point policy: %s", d.Id())
 		_, err := conn.PutAccessPointPolicy(&s3control.PutAccessPointPolicyInput{
 			AccountId: aws.String(accountId),
@@ -237,7 +238,7 @@ func resourceAwsS3AccessPointRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	d.Set("account_id", accountId)
-	d.Set("domain_name", meta.(*AWSClient).RegionalHostname(fmt.Sprintf("%s-%s.s3-accesspoint", aws.StringValue(output.Name), accountId)))
+	d.Set("domain_name", meta.(*AWSClient).S3RegionalHostname(fmt.Sprintf("%s-%s.s3-accesspoint", aws.StringValue(output.Name), accountId)))
 	d.Set("name", output.Name)
 	d.Set("network_origin", output.NetworkOrigin)
 	if err := d.Set("public_access_block_configuration", flattenS3AccessPointPublicAccessBlockConfiguration(output.PublicAccessBlockConfiguration)); err != nil {
@@ -296,7 +297,7 @@ func resourceAwsS3AccessPointUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if d.HasChange("policy") {
-		if v, ok := d.GetOk("policy"); ok {
+		if v, ok := d.GetOk("policy"); ok && v.(string) != "{}" {
 			log.Printf("[DEBUG] Putting S3 Access Point policy: %s", d.Id())
 			_, err := conn.PutAccessPointPolicy(&s3control.PutAccessPointPolicyInput{
 				AccountId: aws.String(accountId),
@@ -348,6 +349,16 @@ func resourceAwsS3AccessPointDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+// suppressEquivalentS3AccessPointPolicyDiffs treats an empty JSON object as
+// "no policy", which is how the policy is removed now that it is Computed.
+func suppressEquivalentS3AccessPointPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" && new == "{}" {
+		return true
+	}
+
+	return suppressEquivalentAwsPolicyDiffs(k, old, new, d)
+}
+
 // s3AccessPointParseId returns the Account ID and Access Point Name (S3) or ARN (S3 on Outposts)
 func s3AccessPointParseId(id string) (string, string, error) {
 	parsedARN, err := arn.Parse(id)
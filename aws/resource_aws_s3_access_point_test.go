@@ -654,6 +654,7 @@ resource "aws_s3_bucket" "test" {
 resource "aws_s3_access_point" "test" {
   bucket = aws_s3_bucket.test.bucket
   name   = %[1]q
+  policy = "{}"
 
   public_access_block_configuration {
     block_public_acls       = true
@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -19,12 +20,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
@@ -50,7 +53,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 		Update: resourceAwsS3BucketUpdate,
 		Delete: resourceAwsS3BucketDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsS3BucketImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -97,6 +100,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"grant": {
 				Type:          schema.TypeSet,
 				Optional:      true,
+				Computed:      true,
 				Set:           grantHash,
 				ConflictsWith: []string{"acl"},
 				Elem: &schema.Resource{
@@ -142,6 +146,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"cors_rule": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allowed_headers": {
@@ -175,6 +180,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"website": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -256,6 +262,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"logging": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_bucket": {
@@ -280,6 +287,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"lifecycle_rule": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -293,6 +301,16 @@ func resourceAwsS3Bucket() *schema.Resource {
 							Optional: true,
 						},
 						"tags": tagsSchema(),
+						"object_size_greater_than": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"object_size_less_than": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
 						"enabled": {
 							Type:     schema.TypeBool,
 							Required: true,
@@ -335,6 +353,11 @@ func resourceAwsS3Bucket() *schema.Resource {
 										Optional:     true,
 										ValidateFunc: validation.IntAtLeast(1),
 									},
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+									},
 								},
 							},
 						},
@@ -373,6 +396,11 @@ func resourceAwsS3Bucket() *schema.Resource {
 										Optional:     true,
 										ValidateFunc: validation.IntAtLeast(0),
 									},
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+									},
 									"storage_class": {
 										Type:         schema.TypeString,
 										Required:     true,
@@ -385,6 +413,13 @@ func resourceAwsS3Bucket() *schema.Resource {
 				},
 			},
 
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -467,6 +502,50 @@ func resourceAwsS3Bucket() *schema.Resource {
 														},
 													},
 												},
+												"metrics": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 1,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"minutes": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Default:      15,
+																ValidateFunc: validation.IntBetween(10, 15),
+															},
+															"status": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Default:      s3.MetricsStatusEnabled,
+																ValidateFunc: validation.StringInSlice(s3.MetricsStatus_Values(), false),
+															},
+														},
+													},
+												},
+												"replication_time": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 1,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"minutes": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Default:      15,
+																ValidateFunc: validation.IntBetween(15, 15),
+															},
+															"status": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Default:      s3.ReplicationTimeStatusEnabled,
+																ValidateFunc: validation.StringInSlice(s3.ReplicationTimeStatus_Values(), false),
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -477,6 +556,20 @@ func resourceAwsS3Bucket() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"replica_modifications": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 1,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"enabled": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+														},
+													},
+												},
 												"sse_kms_encrypted_objects": {
 													Type:     schema.TypeList,
 													Optional: true,
@@ -529,6 +622,11 @@ func resourceAwsS3Bucket() *schema.Resource {
 										Optional:     true,
 										ValidateFunc: validation.StringInSlice([]string{s3.DeleteMarkerReplicationStatusEnabled}, false),
 									},
+									"existing_object_replication_status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{s3.ExistingObjectReplicationStatusEnabled}, false),
+									},
 								},
 							},
 						},
@@ -540,6 +638,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rule": {
@@ -634,7 +733,10 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"tags_all": tagsSchemaComputed(),
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			s3BucketReplicationConfigurationDiff,
+		),
 	}
 }
 
@@ -714,6 +816,7 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	s3conn := meta.(*AWSClient).s3conn
 
 	if d.HasChange("tags_all") {
@@ -721,7 +824,7 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		// Retry due to S3 eventual consistency
 		_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-			terr := keyvaluetags.S3BucketUpdateTags(s3conn, d.Id(), o, n)
+			terr := keyvaluetags.S3BucketUpdateTags(s3conn, d.Id(), expectedBucketOwner, o, n)
 			return nil, terr
 		})
 		if err != nil {
@@ -748,12 +851,12 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("versioning") {
-		if err := resourceAwsS3BucketVersioningUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketInternalVersioningUpdate(s3conn, d); err != nil {
 			return err
 		}
 	}
 	if d.HasChange("acl") && !d.IsNewResource() {
-		if err := resourceAwsS3BucketAclUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketInternalAclUpdate(s3conn, d); err != nil {
 			return err
 		}
 	}
@@ -765,7 +868,7 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("logging") {
-		if err := resourceAwsS3BucketLoggingUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketInternalLoggingUpdate(s3conn, d); err != nil {
 			return err
 		}
 	}
@@ -795,7 +898,7 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("server_side_encryption_configuration") {
-		if err := resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketInternalServerSideEncryptionConfigurationUpdate(s3conn, d); err != nil {
 			return err
 		}
 	}
@@ -810,6 +913,7 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	s3conn := meta.(*AWSClient).s3conn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
@@ -818,6 +922,10 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		Bucket: aws.String(d.Id()),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	err := resource.Retry(s3BucketCreationTimeout, func() *resource.RetryError {
 		_, err := s3conn.HeadBucket(input)
 
@@ -867,9 +975,15 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	if _, ok := d.GetOk("policy"); ok {
 
 		pol, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-			return s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+			input := &s3.GetBucketPolicyInput{
 				Bucket: aws.String(d.Id()),
-			})
+			}
+
+			if expectedBucketOwner != "" {
+				input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+			}
+
+			return s3conn.GetBucketPolicy(input)
 		})
 		log.Printf("[DEBUG] S3 bucket: %s, read policy: %v", d.Id(), pol)
 		if err != nil {
@@ -898,9 +1012,15 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	} else {
 		apResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-			return s3conn.GetBucketAcl(&s3.GetBucketAclInput{
+			input := &s3.GetBucketAclInput{
 				Bucket: aws.String(d.Id()),
-			})
+			}
+
+			if expectedBucketOwner != "" {
+				input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+			}
+
+			return s3conn.GetBucketAcl(input)
 		})
 		if err != nil {
 			return fmt.Errorf("error getting S3 Bucket (%s) ACL: %s", d.Id(), err)
@@ -914,9 +1034,15 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the CORS
 	corsResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return s3conn.GetBucketCors(&s3.GetBucketCorsInput{
+		input := &s3.GetBucketCorsInput{
 			Bucket: aws.String(d.Id()),
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		return s3conn.GetBucketCors(input)
 	})
 	if err != nil && !isAWSErr(err, "NoSuchCORSConfiguration", "") {
 		return fmt.Errorf("error getting S3 Bucket CORS configuration: %s", err)
@@ -946,9 +1072,15 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the website configuration
 	wsResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+		input := &s3.GetBucketWebsiteInput{
 			Bucket: aws.String(d.Id()),
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		return s3conn.GetBucketWebsite(input)
 	})
 	if err != nil && !isAWSErr(err, "NotImplemented", "") && !isAWSErr(err, "NoSuchWebsiteConfiguration", "") {
 		return fmt.Errorf("error getting S3 Bucket website configuration: %s", err)
@@ -1013,9 +1145,15 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	// Read the versioning configuration
 
 	versioningResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		input := &s3.GetBucketVersioningInput{
 			Bucket: aws.String(d.Id()),
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		return s3conn.GetBucketVersioning(input)
 	})
 	if err != nil {
 		return err
@@ -1044,9 +1182,15 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	// Read the acceleration status
 
 	accelerateResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return s3conn.GetBucketAccelerateConfiguration(&s3.GetBucketAccelerateConfigurationInput{
+		input := &s3.GetBucketAccelerateConfigurationInput{
 			Bucket: aws.String(d.Id()),
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		return s3conn.GetBucketAccelerateConfiguration(input)
 	})
 
 	// Amazon S3 Transfer Acceleration might not be supported in the region
@@ -1060,9 +1204,15 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	// Read the request payer configuration.
 
 	payerResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return s3conn.GetBucketRequestPayment(&s3.GetBucketRequestPaymentInput{
+		input := &s3.GetBucketRequestPaymentInput{
 			Bucket: aws.String(d.Id()),
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		return s3conn.GetBucketRequestPayment(input)
 	})
 
 	if err != nil {
@@ -1075,9 +1225,15 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the logging configuration
 	loggingResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+		input := &s3.GetBucketLoggingInput{
 			Bucket: aws.String(d.Id()),
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		return s3conn.GetBucketLogging(input)
 	})
 
 	if err != nil {
@@ -1103,9 +1259,15 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	// Read the lifecycle configuration
 
 	lifecycleResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		input := &s3.GetBucketLifecycleConfigurationInput{
 			Bucket: aws.String(d.Id()),
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		return s3conn.GetBucketLifecycleConfiguration(input)
 	})
 	if err != nil && !isAWSErr(err, "NoSuchLifecycleConfiguration", "") {
 		return err
@@ -1123,27 +1285,8 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 			if lifecycleRule.ID != nil && aws.StringValue(lifecycleRule.ID) != "" {
 				rule["id"] = aws.StringValue(lifecycleRule.ID)
 			}
-			filter := lifecycleRule.Filter
-			if filter != nil {
-				if filter.And != nil {
-					// Prefix
-					if filter.And.Prefix != nil && aws.StringValue(filter.And.Prefix) != "" {
-						rule["prefix"] = aws.StringValue(filter.And.Prefix)
-					}
-					// Tag
-					if len(filter.And.Tags) > 0 {
-						rule["tags"] = keyvaluetags.S3KeyValueTags(filter.And.Tags).IgnoreAws().Map()
-					}
-				} else {
-					// Prefix
-					if filter.Prefix != nil && aws.StringValue(filter.Prefix) != "" {
-						rule["prefix"] = aws.StringValue(filter.Prefix)
-					}
-					// Tag
-					if filter.Tag != nil {
-						rule["tags"] = keyvaluetags.S3KeyValueTags([]*s3.Tag{filter.Tag}).IgnoreAws().Map()
-					}
-				}
+			if filter := lifecycleRule.Filter; filter != nil {
+				flattenS3BucketLifecycleInlineRuleFilter(rule, filter)
 			} else {
 				if lifecycleRule.Prefix != nil {
 					rule["prefix"] = aws.StringValue(lifecycleRule.Prefix)
@@ -1186,6 +1329,9 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 				if lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
 					e["days"] = int(aws.Int64Value(lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays))
 				}
+				if lifecycleRule.NoncurrentVersionExpiration.NewerNoncurrentVersions != nil {
+					e["newer_noncurrent_versions"] = int(aws.Int64Value(lifecycleRule.NoncurrentVersionExpiration.NewerNoncurrentVersions))
+				}
 				rule["noncurrent_version_expiration"] = []interface{}{e}
 			}
 			//// transition
@@ -1214,6 +1360,9 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 					if v.NoncurrentDays != nil {
 						t["days"] = int(aws.Int64Value(v.NoncurrentDays))
 					}
+					if v.NewerNoncurrentVersions != nil {
+						t["newer_noncurrent_versions"] = int(aws.Int64Value(v.NewerNoncurrentVersions))
+					}
 					if v.StorageClass != nil {
 						t["storage_class"] = aws.StringValue(v.StorageClass)
 					}
@@ -1232,9 +1381,15 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	// Read the bucket replication configuration
 
 	replicationResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+		input := &s3.GetBucketReplicationInput{
 			Bucket: aws.String(d.Id()),
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		return s3conn.GetBucketReplication(input)
 	})
 	if err != nil && !isAWSErr(err, "ReplicationConfigurationNotFoundError", "") {
 		return fmt.Errorf("error getting S3 Bucket replication: %s", err)
@@ -1251,9 +1406,15 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	// Read the bucket server side encryption configuration
 
 	encryptionResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return s3conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+		input := &s3.GetBucketEncryptionInput{
 			Bucket: aws.String(d.Id()),
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		return s3conn.GetBucketEncryption(input)
 	})
 	if err != nil && !isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "encryption configuration was not found") {
 		return fmt.Errorf("error getting S3 Bucket encryption: %s", err)
@@ -1268,7 +1429,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Object Lock configuration.
-	if conf, err := readS3ObjectLockConfiguration(s3conn, d.Id()); err != nil {
+	if conf, err := readS3ObjectLockConfiguration(s3conn, d.Id(), expectedBucketOwner); err != nil {
 		return fmt.Errorf("error getting S3 Bucket Object Lock configuration: %s", err)
 	} else {
 		if err := d.Set("object_lock_configuration", conf); err != nil {
@@ -1302,7 +1463,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Add the bucket_regional_domain_name as an attribute
-	regionalEndpoint, err := BucketRegionalDomainName(d.Get("bucket").(string), region)
+	regionalEndpoint, err := bucketRegionalDomainName(meta.(*AWSClient).EndpointResolver(), d.Get("bucket").(string), region)
 	if err != nil {
 		return err
 	}
@@ -1332,7 +1493,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Retry due to S3 eventual consistency
 	tagsRaw, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return keyvaluetags.S3BucketListTags(s3conn, d.Id())
+		return keyvaluetags.S3BucketListTags(s3conn, d.Id(), expectedBucketOwner)
 	})
 
 	if err != nil {
@@ -1366,13 +1527,31 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceAwsS3BucketImport imports an S3 Bucket by name, optionally suffixed with the expected bucket owner
+// account ID, e.g. my-bucket,123456789012. The resource ID is the bucket name.
+func resourceAwsS3BucketImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
+
+	d.SetId(bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	s3conn := meta.(*AWSClient).s3conn
 
 	log.Printf("[DEBUG] S3 Delete Bucket: %s", d.Id())
-	_, err := s3conn.DeleteBucket(&s3.DeleteBucketInput{
+	input := &s3.DeleteBucketInput{
 		Bucket: aws.String(d.Id()),
-	})
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := s3conn.DeleteBucket(input)
 
 	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
 		return nil
@@ -1395,7 +1574,7 @@ func resourceAwsS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
 			if objectLockConfiguration != nil {
 				objectLockEnabled = aws.StringValue(objectLockConfiguration.ObjectLockEnabled) == s3.ObjectLockEnabledEnabled
 			}
-			err = deleteAllS3ObjectVersions(s3conn, d.Id(), "", objectLockEnabled, false)
+			err = deleteAllS3ObjectVersions(s3conn, d.Id(), "", expectedBucketOwner, objectLockEnabled, false)
 
 			if err != nil {
 				return fmt.Errorf("error S3 Bucket force_destroy: %s", err)
@@ -1415,6 +1594,7 @@ func resourceAwsS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	policy := d.Get("policy").(string)
 
 	if policy != "" {
@@ -1425,6 +1605,10 @@ func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 			Policy: aws.String(policy),
 		}
 
+		if expectedBucketOwner != "" {
+			params.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
 		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
 			_, err := s3conn.PutBucketPolicy(params)
 			if isAWSErr(err, "MalformedPolicy", "") || isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
@@ -1444,9 +1628,15 @@ func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 	} else {
 		log.Printf("[DEBUG] S3 bucket: %s, delete policy: %s", bucket, policy)
 		_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-			return s3conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
+			input := &s3.DeleteBucketPolicyInput{
 				Bucket: aws.String(bucket),
-			})
+			}
+
+			if expectedBucketOwner != "" {
+				input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+			}
+
+			return s3conn.DeleteBucketPolicy(input)
 		})
 
 		if err != nil {
@@ -1459,18 +1649,25 @@ func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 
 func resourceAwsS3BucketGrantsUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	rawGrants := d.Get("grant").(*schema.Set).List()
 
 	if len(rawGrants) == 0 {
 		log.Printf("[DEBUG] S3 bucket: %s, Grants fallback to canned ACL", bucket)
-		if err := resourceAwsS3BucketAclUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketInternalAclUpdate(s3conn, d); err != nil {
 			return fmt.Errorf("Error fallback to canned ACL, %s", err)
 		}
 	} else {
 		apResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-			return s3conn.GetBucketAcl(&s3.GetBucketAclInput{
+			input := &s3.GetBucketAclInput{
 				Bucket: aws.String(d.Id()),
-			})
+			}
+
+			if expectedBucketOwner != "" {
+				input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+			}
+
+			return s3conn.GetBucketAcl(input)
 		})
 
 		if err != nil {
@@ -1512,6 +1709,10 @@ func resourceAwsS3BucketGrantsUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 			},
 		}
 
+		if expectedBucketOwner != "" {
+			grantsInput.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
 		log.Printf("[DEBUG] S3 bucket: %s, put Grants: %#v", bucket, grantsInput)
 
 		_, err = retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
@@ -1527,6 +1728,7 @@ func resourceAwsS3BucketGrantsUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 
 func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	rawCors := d.Get("cors_rule").([]interface{})
 
 	if len(rawCors) == 0 {
@@ -1534,9 +1736,15 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 		log.Printf("[DEBUG] S3 bucket: %s, delete CORS", bucket)
 
 		_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-			return s3conn.DeleteBucketCors(&s3.DeleteBucketCorsInput{
+			input := &s3.DeleteBucketCorsInput{
 				Bucket: aws.String(bucket),
-			})
+			}
+
+			if expectedBucketOwner != "" {
+				input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+			}
+
+			return s3conn.DeleteBucketCors(input)
 		})
 		if err != nil {
 			return fmt.Errorf("Error deleting S3 CORS: %s", err)
@@ -1578,6 +1786,11 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 				CORSRules: rules,
 			},
 		}
+
+		if expectedBucketOwner != "" {
+			corsInput.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
 		log.Printf("[DEBUG] S3 bucket: %s, put CORS: %#v", bucket, corsInput)
 
 		_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1609,6 +1822,7 @@ func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 
 func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 
 	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
 	if v, ok := website["index_document"]; ok {
@@ -1669,6 +1883,10 @@ func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, websit
 		WebsiteConfiguration: websiteConfiguration,
 	}
 
+	if expectedBucketOwner != "" {
+		putInput.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] S3 put bucket website: %#v", putInput)
 
 	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1683,8 +1901,13 @@ func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, websit
 
 func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	deleteInput := &s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucket)}
 
+	if expectedBucketOwner != "" {
+		deleteInput.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] S3 delete bucket website: %#v", deleteInput)
 
 	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1708,15 +1931,20 @@ func websiteEndpoint(client *AWSClient, d *schema.ResourceData) (*S3Website, err
 	}
 
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 
 	// Lookup the region for this bucket
 
 	locationResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return client.s3conn.GetBucketLocation(
-			&s3.GetBucketLocationInput{
-				Bucket: aws.String(bucket),
-			},
-		)
+		input := &s3.GetBucketLocationInput{
+			Bucket: aws.String(bucket),
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		return client.s3conn.GetBucketLocation(input)
 	})
 	if err != nil {
 		return nil, err
@@ -1732,12 +1960,18 @@ func websiteEndpoint(client *AWSClient, d *schema.ResourceData) (*S3Website, err
 
 // https://docs.aws.amazon.com/general/latest/gr/rande.html#s3_region
 func BucketRegionalDomainName(bucket string, region string) (string, error) {
+	return bucketRegionalDomainName(endpoints.DefaultResolver(), bucket, region)
+}
+
+// bucketRegionalDomainName returns the bucket regional domain name using the specified endpoint resolver,
+// e.g. the resolver for the provider FIPS and dual-stack endpoint configuration.
+func bucketRegionalDomainName(resolver endpoints.Resolver, bucket string, region string) (string, error) {
 	// Return a default AWS Commercial domain name if no region is provided
 	// Otherwise EndpointFor() will return BUCKET.s3..amazonaws.com
 	if region == "" {
 		return fmt.Sprintf("%s.s3.amazonaws.com", bucket), nil //lintignore:AWSR001
 	}
-	endpoint, err := endpoints.DefaultResolver().EndpointFor(endpoints.S3ServiceID, region)
+	endpoint, err := resolver.EndpointFor(endpoints.S3ServiceID, region)
 	if err != nil {
 		return "", err
 	}
@@ -1781,14 +2015,20 @@ func isOldRegion(region string) bool {
 	return false
 }
 
-func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketInternalAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	acl := d.Get("acl").(string)
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 
 	i := &s3.PutBucketAclInput{
 		Bucket: aws.String(bucket),
 		ACL:    aws.String(acl),
 	}
+
+	if expectedBucketOwner != "" {
+		i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] S3 put bucket ACL: %#v", i)
 
 	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1801,9 +2041,10 @@ func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketInternalVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	v := d.Get("versioning").([]interface{})
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	vc := &s3.VersioningConfiguration{}
 
 	if len(v) > 0 {
@@ -1829,6 +2070,11 @@ func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData)
 		Bucket:                  aws.String(bucket),
 		VersioningConfiguration: vc,
 	}
+
+	if expectedBucketOwner != "" {
+		i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] S3 put bucket versioning: %#v", i)
 
 	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1841,9 +2087,10 @@ func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData)
 	return nil
 }
 
-func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketInternalLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	logging := d.Get("logging").(*schema.Set).List()
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	loggingStatus := &s3.BucketLoggingStatus{}
 
 	if len(logging) > 0 {
@@ -1864,6 +2111,11 @@ func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 		Bucket:              aws.String(bucket),
 		BucketLoggingStatus: loggingStatus,
 	}
+
+	if expectedBucketOwner != "" {
+		i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] S3 put bucket logging: %#v", i)
 
 	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1878,6 +2130,7 @@ func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 
 func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	enableAcceleration := d.Get("acceleration_status").(string)
 
 	i := &s3.PutBucketAccelerateConfigurationInput{
@@ -1886,6 +2139,11 @@ func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData
 			Status: aws.String(enableAcceleration),
 		},
 	}
+
+	if expectedBucketOwner != "" {
+		i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] S3 put bucket acceleration: %#v", i)
 
 	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1900,6 +2158,7 @@ func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData
 
 func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	payer := d.Get("request_payer").(string)
 
 	i := &s3.PutBucketRequestPaymentInput{
@@ -1908,6 +2167,11 @@ func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData
 			Payer: aws.String(payer),
 		},
 	}
+
+	if expectedBucketOwner != "" {
+		i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] S3 put bucket request payer: %#v", i)
 
 	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1920,8 +2184,9 @@ func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData
 	return nil
 }
 
-func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketInternalServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	serverSideEncryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
 	if len(serverSideEncryptionConfiguration) == 0 {
 		log.Printf("[DEBUG] Delete server side encryption configuration: %#v", serverSideEncryptionConfiguration)
@@ -1929,6 +2194,10 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 			Bucket: aws.String(bucket),
 		}
 
+		if expectedBucketOwner != "" {
+			i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
 		_, err := s3conn.DeleteBucketEncryption(i)
 		if err != nil {
 			return fmt.Errorf("error removing S3 bucket server side encryption: %s", err)
@@ -1969,6 +2238,11 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 		Bucket:                            aws.String(bucket),
 		ServerSideEncryptionConfiguration: rc,
 	}
+
+	if expectedBucketOwner != "" {
+		i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
 
 	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1982,12 +2256,18 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 }
 
 func resourceAwsS3BucketObjectLockConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+
 	// S3 Object Lock configuration cannot be deleted, only updated.
 	req := &s3.PutObjectLockConfigurationInput{
 		Bucket:                  aws.String(d.Get("bucket").(string)),
 		ObjectLockConfiguration: expandS3ObjectLockConfiguration(d.Get("object_lock_configuration").([]interface{})),
 	}
 
+	if expectedBucketOwner != "" {
+		req.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
 		return s3conn.PutObjectLockConfiguration(req)
 	})
@@ -2000,6 +2280,7 @@ func resourceAwsS3BucketObjectLockConfigurationUpdate(s3conn *s3.S3, d *schema.R
 
 func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	replicationConfiguration := d.Get("replication_configuration").([]interface{})
 
 	if len(replicationConfiguration) == 0 {
@@ -2007,6 +2288,10 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 			Bucket: aws.String(bucket),
 		}
 
+		if expectedBucketOwner != "" {
+			i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
 		_, err := s3conn.DeleteBucketReplication(i)
 		if err != nil {
 			return fmt.Errorf("Error removing S3 bucket replication: %s", err)
@@ -2035,138 +2320,51 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 		rc.Role = aws.String(val.(string))
 	}
 
-	rcRules := c["rules"].(*schema.Set).List()
-	rules := []*s3.ReplicationRule{}
-	for _, v := range rcRules {
-		rr := v.(map[string]interface{})
-		rcRule := &s3.ReplicationRule{}
-		if status, ok := rr["status"]; ok && status != "" {
-			rcRule.Status = aws.String(status.(string))
-		} else {
-			continue
-		}
+	rc.Rules = expandS3BucketReplicationRules(c["rules"].(*schema.Set).List())
+	i := &s3.PutBucketReplicationInput{
+		Bucket:                   aws.String(bucket),
+		ReplicationConfiguration: rc,
+	}
 
-		if rrid, ok := rr["id"]; ok && rrid != "" {
-			rcRule.ID = aws.String(rrid.(string))
+	if expectedBucketOwner != "" {
+		i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
+
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := s3conn.PutBucketReplication(i)
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "InvalidRequest", "Versioning must be 'Enabled' on the bucket") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
 		}
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = s3conn.PutBucketReplication(i)
+	}
+	if err != nil {
+		return fmt.Errorf("Error putting S3 replication configuration: %s", err)
+	}
 
-		ruleDestination := &s3.Destination{}
-		if dest, ok := rr["destination"].([]interface{}); ok && len(dest) > 0 {
-			if dest[0] != nil {
-				bd := dest[0].(map[string]interface{})
-				ruleDestination.Bucket = aws.String(bd["bucket"].(string))
+	return nil
+}
 
-				if storageClass, ok := bd["storage_class"]; ok && storageClass != "" {
-					ruleDestination.StorageClass = aws.String(storageClass.(string))
-				}
+func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 
-				if replicaKmsKeyId, ok := bd["replica_kms_key_id"]; ok && replicaKmsKeyId != "" {
-					ruleDestination.EncryptionConfiguration = &s3.EncryptionConfiguration{
-						ReplicaKmsKeyID: aws.String(replicaKmsKeyId.(string)),
-					}
-				}
+	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
 
-				if account, ok := bd["account_id"]; ok && account != "" {
-					ruleDestination.Account = aws.String(account.(string))
-				}
+	if len(lifecycleRules) == 0 || lifecycleRules[0] == nil {
+		i := &s3.DeleteBucketLifecycleInput{
+			Bucket: aws.String(bucket),
+		}
 
-				if aclTranslation, ok := bd["access_control_translation"].([]interface{}); ok && len(aclTranslation) > 0 {
-					aclTranslationValues := aclTranslation[0].(map[string]interface{})
-					ruleAclTranslation := &s3.AccessControlTranslation{}
-					ruleAclTranslation.Owner = aws.String(aclTranslationValues["owner"].(string))
-					ruleDestination.AccessControlTranslation = ruleAclTranslation
-				}
-			}
-		}
-		rcRule.Destination = ruleDestination
-
-		if ssc, ok := rr["source_selection_criteria"].([]interface{}); ok && len(ssc) > 0 {
-			if ssc[0] != nil {
-				sscValues := ssc[0].(map[string]interface{})
-				ruleSsc := &s3.SourceSelectionCriteria{}
-				if sseKms, ok := sscValues["sse_kms_encrypted_objects"].([]interface{}); ok && len(sseKms) > 0 {
-					if sseKms[0] != nil {
-						sseKmsValues := sseKms[0].(map[string]interface{})
-						sseKmsEncryptedObjects := &s3.SseKmsEncryptedObjects{}
-						if sseKmsValues["enabled"].(bool) {
-							sseKmsEncryptedObjects.Status = aws.String(s3.SseKmsEncryptedObjectsStatusEnabled)
-						} else {
-							sseKmsEncryptedObjects.Status = aws.String(s3.SseKmsEncryptedObjectsStatusDisabled)
-						}
-						ruleSsc.SseKmsEncryptedObjects = sseKmsEncryptedObjects
-					}
-				}
-				rcRule.SourceSelectionCriteria = ruleSsc
-			}
-		}
-
-		if f, ok := rr["filter"].([]interface{}); ok && len(f) > 0 && f[0] != nil {
-			// XML schema V2.
-			rcRule.Priority = aws.Int64(int64(rr["priority"].(int)))
-			rcRule.Filter = &s3.ReplicationRuleFilter{}
-			filter := f[0].(map[string]interface{})
-			tags := keyvaluetags.New(filter["tags"]).IgnoreAws().S3Tags()
-			if len(tags) > 0 {
-				rcRule.Filter.And = &s3.ReplicationRuleAndOperator{
-					Prefix: aws.String(filter["prefix"].(string)),
-					Tags:   tags,
-				}
-			} else {
-				rcRule.Filter.Prefix = aws.String(filter["prefix"].(string))
-			}
-
-			if dmr, ok := rr["delete_marker_replication_status"].(string); ok && dmr != "" {
-				rcRule.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
-					Status: aws.String(dmr),
-				}
-			} else {
-				rcRule.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
-					Status: aws.String(s3.DeleteMarkerReplicationStatusDisabled),
-				}
-			}
-		} else {
-			// XML schema V1.
-			rcRule.Prefix = aws.String(rr["prefix"].(string))
-		}
-
-		rules = append(rules, rcRule)
-	}
-
-	rc.Rules = rules
-	i := &s3.PutBucketReplicationInput{
-		Bucket:                   aws.String(bucket),
-		ReplicationConfiguration: rc,
-	}
-	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
-
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := s3conn.PutBucketReplication(i)
-		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "InvalidRequest", "Versioning must be 'Enabled' on the bucket") {
-			return resource.RetryableError(err)
-		}
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-	if isResourceTimeoutError(err) {
-		_, err = s3conn.PutBucketReplication(i)
-	}
-	if err != nil {
-		return fmt.Errorf("Error putting S3 replication configuration: %s", err)
-	}
-
-	return nil
-}
-
-func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
-
-	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
-
-	if len(lifecycleRules) == 0 || lifecycleRules[0] == nil {
-		i := &s3.DeleteBucketLifecycleInput{
-			Bucket: aws.String(bucket),
+		if expectedBucketOwner != "" {
+			i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 		}
 
 		_, err := s3conn.DeleteBucketLifecycle(i)
@@ -2184,17 +2382,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		rule := &s3.LifecycleRule{}
 
 		// Filter
-		tags := keyvaluetags.New(r["tags"]).IgnoreAws().S3Tags()
-		filter := &s3.LifecycleRuleFilter{}
-		if len(tags) > 0 {
-			lifecycleRuleAndOp := &s3.LifecycleRuleAndOperator{}
-			lifecycleRuleAndOp.SetPrefix(r["prefix"].(string))
-			lifecycleRuleAndOp.SetTags(tags)
-			filter.SetAnd(lifecycleRuleAndOp)
-		} else {
-			filter.SetPrefix(r["prefix"].(string))
-		}
-		rule.SetFilter(filter)
+		rule.SetFilter(expandS3BucketLifecycleInlineRuleFilter(r))
 
 		// ID
 		if val, ok := r["id"].(string); ok && val != "" {
@@ -2245,6 +2433,10 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 				rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
 					NoncurrentDays: aws.Int64(int64(val)),
 				}
+
+				if val, ok := e["newer_noncurrent_versions"].(int); ok && val > 0 {
+					rule.NoncurrentVersionExpiration.NewerNoncurrentVersions = aws.Int64(int64(val))
+				}
 			}
 		}
 
@@ -2281,6 +2473,9 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 				if val, ok := transition["days"].(int); ok && val >= 0 {
 					i.NoncurrentDays = aws.Int64(int64(val))
 				}
+				if val, ok := transition["newer_noncurrent_versions"].(int); ok && val > 0 {
+					i.NewerNoncurrentVersions = aws.Int64(int64(val))
+				}
 				if val, ok := transition["storage_class"].(string); ok && val != "" {
 					i.StorageClass = aws.String(val)
 				}
@@ -2308,6 +2503,10 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		},
 	}
 
+	if expectedBucketOwner != "" {
+		i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
 		return s3conn.PutBucketLifecycleConfiguration(i)
 	})
@@ -2318,6 +2517,96 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 	return nil
 }
 
+// expandS3BucketLifecycleInlineRuleFilter returns the filter of an inline "lifecycle_rule" block.
+// Tags, or more than one of the prefix and object size conditions, are combined in an And operator.
+func expandS3BucketLifecycleInlineRuleFilter(tfMap map[string]interface{}) *s3.LifecycleRuleFilter {
+	prefix, _ := tfMap["prefix"].(string)
+	tags := keyvaluetags.New(tfMap["tags"]).IgnoreAws().S3Tags()
+	objectSizeGreaterThan, _ := tfMap["object_size_greater_than"].(int)
+	objectSizeLessThan, _ := tfMap["object_size_less_than"].(int)
+
+	conditions := 0
+
+	for _, ok := range []bool{prefix != "", objectSizeGreaterThan > 0, objectSizeLessThan > 0} {
+		if ok {
+			conditions++
+		}
+	}
+
+	filter := &s3.LifecycleRuleFilter{}
+
+	switch {
+	case len(tags) > 0 || conditions > 1:
+		andOp := &s3.LifecycleRuleAndOperator{}
+		andOp.SetPrefix(prefix)
+
+		if len(tags) > 0 {
+			andOp.SetTags(tags)
+		}
+
+		if objectSizeGreaterThan > 0 {
+			andOp.SetObjectSizeGreaterThan(int64(objectSizeGreaterThan))
+		}
+
+		if objectSizeLessThan > 0 {
+			andOp.SetObjectSizeLessThan(int64(objectSizeLessThan))
+		}
+
+		filter.SetAnd(andOp)
+	case objectSizeGreaterThan > 0:
+		filter.SetObjectSizeGreaterThan(int64(objectSizeGreaterThan))
+	case objectSizeLessThan > 0:
+		filter.SetObjectSizeLessThan(int64(objectSizeLessThan))
+	default:
+		filter.SetPrefix(prefix)
+	}
+
+	return filter
+}
+
+// flattenS3BucketLifecycleInlineRuleFilter sets the filter attributes of an inline "lifecycle_rule" block.
+func flattenS3BucketLifecycleInlineRuleFilter(tfMap map[string]interface{}, filter *s3.LifecycleRuleFilter) {
+	if filter == nil {
+		return
+	}
+
+	if andOp := filter.And; andOp != nil {
+		if v := aws.StringValue(andOp.Prefix); v != "" {
+			tfMap["prefix"] = v
+		}
+
+		if len(andOp.Tags) > 0 {
+			tfMap["tags"] = keyvaluetags.S3KeyValueTags(andOp.Tags).IgnoreAws().Map()
+		}
+
+		if v := aws.Int64Value(andOp.ObjectSizeGreaterThan); v > 0 {
+			tfMap["object_size_greater_than"] = int(v)
+		}
+
+		if v := aws.Int64Value(andOp.ObjectSizeLessThan); v > 0 {
+			tfMap["object_size_less_than"] = int(v)
+		}
+
+		return
+	}
+
+	if v := aws.StringValue(filter.Prefix); v != "" {
+		tfMap["prefix"] = v
+	}
+
+	if filter.Tag != nil {
+		tfMap["tags"] = keyvaluetags.S3KeyValueTags([]*s3.Tag{filter.Tag}).IgnoreAws().Map()
+	}
+
+	if v := aws.Int64Value(filter.ObjectSizeGreaterThan); v > 0 {
+		tfMap["object_size_greater_than"] = int(v)
+	}
+
+	if v := aws.Int64Value(filter.ObjectSizeLessThan); v > 0 {
+		tfMap["object_size_less_than"] = int(v)
+	}
+}
+
 func flattenAwsS3ServerSideEncryptionConfiguration(c *s3.ServerSideEncryptionConfiguration) []map[string]interface{} {
 	var encryptionConfiguration []map[string]interface{}
 	rules := make([]interface{}, 0, len(c.Rules))
@@ -2338,6 +2627,246 @@ func flattenAwsS3ServerSideEncryptionConfiguration(c *s3.ServerSideEncryptionCon
 	return encryptionConfiguration
 }
 
+func expandS3BucketReplicationRules(tfList []interface{}) []*s3.ReplicationRule {
+	rules := []*s3.ReplicationRule{}
+	for _, v := range tfList {
+		rr := v.(map[string]interface{})
+		rcRule := &s3.ReplicationRule{}
+		if status, ok := rr["status"]; ok && status != "" {
+			rcRule.Status = aws.String(status.(string))
+		} else {
+			continue
+		}
+
+		if rrid, ok := rr["id"]; ok && rrid != "" {
+			rcRule.ID = aws.String(rrid.(string))
+		}
+
+		ruleDestination := &s3.Destination{}
+		if dest, ok := rr["destination"].([]interface{}); ok && len(dest) > 0 {
+			if dest[0] != nil {
+				bd := dest[0].(map[string]interface{})
+				ruleDestination.Bucket = aws.String(bd["bucket"].(string))
+
+				if storageClass, ok := bd["storage_class"]; ok && storageClass != "" {
+					ruleDestination.StorageClass = aws.String(storageClass.(string))
+				}
+
+				if replicaKmsKeyId, ok := bd["replica_kms_key_id"]; ok && replicaKmsKeyId != "" {
+					ruleDestination.EncryptionConfiguration = &s3.EncryptionConfiguration{
+						ReplicaKmsKeyID: aws.String(replicaKmsKeyId.(string)),
+					}
+				}
+
+				if account, ok := bd["account_id"]; ok && account != "" {
+					ruleDestination.Account = aws.String(account.(string))
+				}
+
+				if aclTranslation, ok := bd["access_control_translation"].([]interface{}); ok && len(aclTranslation) > 0 {
+					aclTranslationValues := aclTranslation[0].(map[string]interface{})
+					ruleAclTranslation := &s3.AccessControlTranslation{}
+					ruleAclTranslation.Owner = aws.String(aclTranslationValues["owner"].(string))
+					ruleDestination.AccessControlTranslation = ruleAclTranslation
+				}
+
+				if metrics, ok := bd["metrics"].([]interface{}); ok && len(metrics) > 0 && metrics[0] != nil {
+					metricsValues := metrics[0].(map[string]interface{})
+					ruleMetrics := &s3.Metrics{
+						Status: aws.String(metricsValues["status"].(string)),
+					}
+					if aws.StringValue(ruleMetrics.Status) == s3.MetricsStatusEnabled {
+						ruleMetrics.EventThreshold = &s3.ReplicationTimeValue{
+							Minutes: aws.Int64(int64(metricsValues["minutes"].(int))),
+						}
+					}
+					ruleDestination.Metrics = ruleMetrics
+				}
+
+				if replicationTime, ok := bd["replication_time"].([]interface{}); ok && len(replicationTime) > 0 && replicationTime[0] != nil {
+					replicationTimeValues := replicationTime[0].(map[string]interface{})
+					ruleDestination.ReplicationTime = &s3.ReplicationTime{
+						Status: aws.String(replicationTimeValues["status"].(string)),
+						Time: &s3.ReplicationTimeValue{
+							Minutes: aws.Int64(int64(replicationTimeValues["minutes"].(int))),
+						},
+					}
+				}
+			}
+		}
+		rcRule.Destination = ruleDestination
+
+		if ssc, ok := rr["source_selection_criteria"].([]interface{}); ok && len(ssc) > 0 {
+			if ssc[0] != nil {
+				sscValues := ssc[0].(map[string]interface{})
+				ruleSsc := &s3.SourceSelectionCriteria{}
+				if sseKms, ok := sscValues["sse_kms_encrypted_objects"].([]interface{}); ok && len(sseKms) > 0 {
+					if sseKms[0] != nil {
+						sseKmsValues := sseKms[0].(map[string]interface{})
+						sseKmsEncryptedObjects := &s3.SseKmsEncryptedObjects{}
+						if sseKmsValues["enabled"].(bool) {
+							sseKmsEncryptedObjects.Status = aws.String(s3.SseKmsEncryptedObjectsStatusEnabled)
+						} else {
+							sseKmsEncryptedObjects.Status = aws.String(s3.SseKmsEncryptedObjectsStatusDisabled)
+						}
+						ruleSsc.SseKmsEncryptedObjects = sseKmsEncryptedObjects
+					}
+				}
+				if replicaModifications, ok := sscValues["replica_modifications"].([]interface{}); ok && len(replicaModifications) > 0 {
+					if replicaModifications[0] != nil {
+						replicaModificationsValues := replicaModifications[0].(map[string]interface{})
+						ruleReplicaModifications := &s3.ReplicaModifications{}
+						if replicaModificationsValues["enabled"].(bool) {
+							ruleReplicaModifications.Status = aws.String(s3.ReplicaModificationsStatusEnabled)
+						} else {
+							ruleReplicaModifications.Status = aws.String(s3.ReplicaModificationsStatusDisabled)
+						}
+						ruleSsc.ReplicaModifications = ruleReplicaModifications
+					}
+				}
+				rcRule.SourceSelectionCriteria = ruleSsc
+			}
+		}
+
+		if f, ok := rr["filter"].([]interface{}); ok && len(f) > 0 && f[0] != nil {
+			// XML schema V2.
+			rcRule.Priority = aws.Int64(int64(rr["priority"].(int)))
+			rcRule.Filter = &s3.ReplicationRuleFilter{}
+			filter := f[0].(map[string]interface{})
+			tags := keyvaluetags.New(filter["tags"]).IgnoreAws().S3Tags()
+			if len(tags) > 0 {
+				rcRule.Filter.And = &s3.ReplicationRuleAndOperator{
+					Prefix: aws.String(filter["prefix"].(string)),
+					Tags:   tags,
+				}
+			} else {
+				rcRule.Filter.Prefix = aws.String(filter["prefix"].(string))
+			}
+
+			if dmr, ok := rr["delete_marker_replication_status"].(string); ok && dmr != "" {
+				rcRule.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
+					Status: aws.String(dmr),
+				}
+			} else {
+				rcRule.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
+					Status: aws.String(s3.DeleteMarkerReplicationStatusDisabled),
+				}
+			}
+
+			if eor, ok := rr["existing_object_replication_status"].(string); ok && eor != "" {
+				rcRule.ExistingObjectReplication = &s3.ExistingObjectReplication{
+					Status: aws.String(eor),
+				}
+			}
+		} else {
+			// XML schema V1.
+			rcRule.Prefix = aws.String(rr["prefix"].(string))
+		}
+
+		rules = append(rules, rcRule)
+	}
+
+	return rules
+}
+
+// s3BucketReplicationConfigurationDiff returns an error if the replication rules
+// violate the constraints checked by validateS3BucketReplicationRules.
+func s3BucketReplicationConfigurationDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	const prefix = "replication_configuration.0.rules."
+
+	// Reading the "rules" set loses the nested blocks of its elements,
+	// so each rule is read with the code it has in the plan instead.
+	// Rule values that are only known after apply read as zero values,
+	// so distinct priorities are only checked once all rule values are known.
+	codes := make(map[string]struct{})
+	rulesKnown := true
+
+	for _, k := range diff.GetChangedKeysPrefix(prefix) {
+		if !diff.NewValueKnown(k) {
+			rulesKnown = false
+		}
+
+		if code := strings.SplitN(strings.TrimPrefix(k, prefix), ".", 2)[0]; code != "#" {
+			codes[code] = struct{}{}
+		}
+	}
+
+	keys := make([]string, 0, len(codes))
+
+	for code := range codes {
+		keys = append(keys, code)
+	}
+
+	sort.Strings(keys)
+
+	tfList := make([]interface{}, 0, len(keys))
+
+	for _, code := range keys {
+		tfMap, ok := diff.Get(prefix + code).(map[string]interface{})
+
+		// Rules removed from the set read as zero values, without the required destination.
+		if !ok || len(tfMap["destination"].([]interface{})) == 0 {
+			continue
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return validateS3BucketReplicationRules(expandS3BucketReplicationRules(tfList), rulesKnown)
+}
+
+// validateS3BucketReplicationRules checks constraints S3 enforces across
+// replication rules that cannot be expressed in the schema. Rule priorities
+// are only checked to be distinct when checkPriorities is set.
+func validateS3BucketReplicationRules(rules []*s3.ReplicationRule, checkPriorities bool) error {
+	priorities := make(map[int64]string)
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		id := aws.StringValue(rule.ID)
+		destination := rule.Destination
+
+		if rule.Filter == nil {
+			// XML schema V1.
+			if destination != nil && (destination.Metrics != nil || destination.ReplicationTime != nil) {
+				return fmt.Errorf("replication rule (%s): metrics and replication_time require a filter", id)
+			}
+
+			if rule.ExistingObjectReplication != nil {
+				return fmt.Errorf("replication rule (%s): existing_object_replication_status requires a filter", id)
+			}
+
+			if rule.SourceSelectionCriteria != nil && rule.SourceSelectionCriteria.ReplicaModifications != nil {
+				return fmt.Errorf("replication rule (%s): replica_modifications requires a filter", id)
+			}
+
+			continue
+		}
+
+		if destination != nil && destination.ReplicationTime != nil && aws.StringValue(destination.ReplicationTime.Status) == s3.ReplicationTimeStatusEnabled {
+			if destination.Metrics == nil || aws.StringValue(destination.Metrics.Status) != s3.MetricsStatusEnabled {
+				return fmt.Errorf("replication rule (%s): replication_time requires metrics to be enabled", id)
+			}
+		}
+
+		if !checkPriorities {
+			continue
+		}
+
+		priority := aws.Int64Value(rule.Priority)
+
+		if other, ok := priorities[priority]; ok {
+			return fmt.Errorf("replication rules (%s) and (%s) have the same priority (%d); each rule must have a distinct priority", other, id, priority)
+		}
+
+		priorities[priority] = id
+	}
+
+	return nil
+}
+
 func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration) []map[string]interface{} {
 	replication_configuration := make([]map[string]interface{}, 0, 1)
 
@@ -2376,6 +2905,24 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 				}
 				rd["access_control_translation"] = []interface{}{rdt}
 			}
+			if v.Destination.Metrics != nil {
+				rdm := map[string]interface{}{
+					"status": aws.StringValue(v.Destination.Metrics.Status),
+				}
+				if v.Destination.Metrics.EventThreshold != nil {
+					rdm["minutes"] = int(aws.Int64Value(v.Destination.Metrics.EventThreshold.Minutes))
+				}
+				rd["metrics"] = []interface{}{rdm}
+			}
+			if v.Destination.ReplicationTime != nil {
+				rdrt := map[string]interface{}{
+					"status": aws.StringValue(v.Destination.ReplicationTime.Status),
+				}
+				if v.Destination.ReplicationTime.Time != nil {
+					rdrt["minutes"] = int(aws.Int64Value(v.Destination.ReplicationTime.Time.Minutes))
+				}
+				rd["replication_time"] = []interface{}{rdrt}
+			}
 			t["destination"] = []interface{}{rd}
 		}
 
@@ -2399,6 +2946,12 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 				}
 				tssc["sse_kms_encrypted_objects"] = []interface{}{tSseKms}
 			}
+			if vssc.ReplicaModifications != nil {
+				tReplicaModifications := map[string]interface{}{
+					"enabled": aws.StringValue(vssc.ReplicaModifications.Status) == s3.ReplicaModificationsStatusEnabled,
+				}
+				tssc["replica_modifications"] = []interface{}{tReplicaModifications}
+			}
 			t["source_selection_criteria"] = []interface{}{tssc}
 		}
 
@@ -2423,6 +2976,10 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 			if v.DeleteMarkerReplication != nil && v.DeleteMarkerReplication.Status != nil && aws.StringValue(v.DeleteMarkerReplication.Status) == s3.DeleteMarkerReplicationStatusEnabled {
 				t["delete_marker_replication_status"] = aws.StringValue(v.DeleteMarkerReplication.Status)
 			}
+
+			if v.ExistingObjectReplication != nil && aws.StringValue(v.ExistingObjectReplication.Status) == s3.ExistingObjectReplicationStatusEnabled {
+				t["existing_object_replication_status"] = aws.StringValue(v.ExistingObjectReplication.Status)
+			}
 		}
 
 		rules = append(rules, t)
@@ -2561,6 +3118,9 @@ func transitionHash(v interface{}) int {
 	if v, ok := m["storage_class"]; ok {
 		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
 	}
+	if v, ok := m["newer_noncurrent_versions"]; ok && v.(int) > 0 {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
 	return hashcode.String(buf.String())
 }
 
@@ -2596,6 +3156,10 @@ func rulesHash(v interface{}) int {
 		if v, ok := m["delete_marker_replication_status"]; ok && v.(string) == s3.DeleteMarkerReplicationStatusEnabled {
 			buf.WriteString(fmt.Sprintf("%s-", v.(string)))
 		}
+
+		if v, ok := m["existing_object_replication_status"]; ok && v.(string) == s3.ExistingObjectReplicationStatusEnabled {
+			buf.WriteString(fmt.Sprintf("eor-%s-", v.(string)))
+		}
 	}
 	return hashcode.String(buf.String())
 }
@@ -2640,6 +3204,34 @@ func destinationHash(v interface{}) int {
 	if v, ok := m["access_control_translation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		buf.WriteString(fmt.Sprintf("%d-", accessControlTranslationHash(v[0])))
 	}
+	if v, ok := m["metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("metrics-%d-", replicationTimeValueHash(v[0])))
+	}
+	if v, ok := m["replication_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("replication-time-%d-", replicationTimeValueHash(v[0])))
+	}
+	return hashcode.String(buf.String())
+}
+
+func replicationTimeValueHash(v interface{}) int {
+	var buf bytes.Buffer
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return 0
+	}
+
+	// The threshold is only returned by S3 for enabled configurations.
+	if v, ok := m["status"]; ok && v.(string) != s3.MetricsStatusEnabled {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+		return hashcode.String(buf.String())
+	}
+	if v, ok := m["minutes"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+	if v, ok := m["status"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
 	return hashcode.String(buf.String())
 }
 
@@ -2668,6 +3260,9 @@ func sourceSelectionCriteriaHash(v interface{}) int {
 	if v, ok := m["sse_kms_encrypted_objects"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		buf.WriteString(fmt.Sprintf("%d-", sourceSseKmsObjectsHash(v[0])))
 	}
+	if v, ok := m["replica_modifications"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("replica-modifications-%d-", sourceSseKmsObjectsHash(v[0])))
+	}
 	return hashcode.String(buf.String())
 }
 
@@ -2693,11 +3288,17 @@ type S3Website struct {
 // S3 Object Lock functions.
 //
 
-func readS3ObjectLockConfiguration(conn *s3.S3, bucket string) ([]interface{}, error) {
+func readS3ObjectLockConfiguration(conn *s3.S3, bucket, expectedBucketOwner string) ([]interface{}, error) {
 	resp, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return conn.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{
+		input := &s3.GetObjectLockConfigurationInput{
 			Bucket: aws.String(bucket),
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		return conn.GetObjectLockConfiguration(input)
 	})
 	if err != nil {
 		// Certain S3 implementations do not include this API
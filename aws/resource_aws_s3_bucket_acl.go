@@ -0,0 +1,341 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+)
+
+func resourceAwsS3BucketAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketAclCreate,
+		Read:   resourceAwsS3BucketAclRead,
+		Update: resourceAwsS3BucketAclUpdate,
+		Delete: resourceAwsS3BucketAclDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_control_policy": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"access_control_policy", "acl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grant": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"grantee": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"display_name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"email_address": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.Type_Values(), false),
+												},
+												"uri": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"permission": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.Permission_Values(), false),
+									},
+								},
+							},
+						},
+						"owner": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"display_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"access_control_policy", "acl"},
+				ValidateFunc: validation.StringInSlice(BucketCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketAclCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	conn := meta.(*AWSClient).s3conn
+
+	input := expandS3BucketAclInput(d, bucket, expectedBucketOwner)
+
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketAcl(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	d.SetId(tfs3.CreateResourceID(bucket, expectedBucketOwner))
+
+	return resourceAwsS3BucketAclRead(d, meta)
+}
+
+func resourceAwsS3BucketAclRead(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketAclInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketAcl(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: empty response", bucket)
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
+
+	// The canned ACL that was applied cannot be read back, only the grants it produced.
+	if err := d.Set("access_control_policy", flattenS3BucketAccessControlPolicy(output)); err != nil {
+		return fmt.Errorf("error setting access_control_policy: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAclUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
+	conn := meta.(*AWSClient).s3conn
+
+	_, err := conn.PutBucketAcl(expandS3BucketAclInput(d, bucket, expectedBucketOwner))
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	return resourceAwsS3BucketAclRead(d, meta)
+}
+
+func resourceAwsS3BucketAclDelete(d *schema.ResourceData, meta interface{}) error {
+	// A bucket always has an ACL, so there is nothing to delete.
+	log.Printf("[WARN] Cannot destroy S3 Bucket ACL (%s). Terraform will remove this resource from the state file, however the ACL will remain on the bucket.", d.Id())
+
+	return nil
+}
+
+func expandS3BucketAclInput(d *schema.ResourceData, bucket, expectedBucketOwner string) *s3.PutBucketAclInput {
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+	} else if v, ok := d.GetOk("access_control_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccessControlPolicy = expandS3BucketAccessControlPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return input
+}
+
+func expandS3BucketAccessControlPolicy(tfMap map[string]interface{}) *s3.AccessControlPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.AccessControlPolicy{}
+
+	if v, ok := tfMap["grant"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Grants = expandS3BucketAclGrants(v.List())
+	}
+
+	if v, ok := tfMap["owner"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Owner = &s3.Owner{
+			ID: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["display_name"].(string); ok && v != "" {
+			apiObject.Owner.DisplayName = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3BucketAclGrants(tfList []interface{}) []*s3.Grant {
+	var apiObjects []*s3.Grant
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.Grant{}
+
+		if v, ok := tfMap["grantee"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Grantee = expandS3BucketAclGrantee(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["permission"].(string); ok && v != "" {
+			apiObject.Permission = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3BucketAclGrantee(tfMap map[string]interface{}) *s3.Grantee {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.Grantee{}
+
+	if v, ok := tfMap["email_address"].(string); ok && v != "" {
+		apiObject.EmailAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.ID = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["uri"].(string); ok && v != "" {
+		apiObject.URI = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3BucketAccessControlPolicy(apiObject *s3.GetBucketAclOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	var grants []interface{}
+
+	for _, grant := range apiObject.Grants {
+		if grant == nil {
+			continue
+		}
+
+		tfGrant := map[string]interface{}{
+			"permission": aws.StringValue(grant.Permission),
+		}
+
+		if v := grant.Grantee; v != nil {
+			tfGrant["grantee"] = []interface{}{
+				map[string]interface{}{
+					"display_name":  aws.StringValue(v.DisplayName),
+					"email_address": aws.StringValue(v.EmailAddress),
+					"id":            aws.StringValue(v.ID),
+					"type":          aws.StringValue(v.Type),
+					"uri":           aws.StringValue(v.URI),
+				},
+			}
+		}
+
+		grants = append(grants, tfGrant)
+	}
+
+	tfMap["grant"] = grants
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = []interface{}{
+			map[string]interface{}{
+				"display_name": aws.StringValue(v.DisplayName),
+				"id":           aws.StringValue(v.ID),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -28,6 +28,12 @@ func resourceAwsS3BucketAnalyticsConfiguration() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -127,9 +133,9 @@ func resourceAwsS3BucketAnalyticsConfiguration() *schema.Resource {
 var filterAtLeastOneOfKeys = []string{"filter.0.prefix", "filter.0.tags"}
 
 func resourceAwsS3BucketAnalyticsConfigurationPut(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
-
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	s3conn := meta.(*AWSClient).s3conn
 	name := d.Get("name").(string)
 
 	log.Printf("[DEBUG] S3 bucket %q, add analytics configuration %q", bucket, name)
@@ -146,6 +152,10 @@ func resourceAwsS3BucketAnalyticsConfigurationPut(d *schema.ResourceData, meta i
 		AnalyticsConfiguration: analyticsConfiguration,
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
 		_, err := s3conn.PutBucketAnalyticsConfiguration(input)
 
@@ -167,20 +177,22 @@ func resourceAwsS3BucketAnalyticsConfigurationPut(d *schema.ResourceData, meta i
 		return fmt.Errorf("error adding S3 Bucket Analytics Configuration: %w", err)
 	}
 
-	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
+	d.SetId(tfs3.CreateResourceID(fmt.Sprintf("%s:%s", bucket, name), expectedBucketOwner))
 
 	return resourceAwsS3BucketAnalyticsConfigurationRead(d, meta)
 }
 
 func resourceAwsS3BucketAnalyticsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	id, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	conn := meta.(*AWSClient).s3conn
 
-	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(d.Id())
+	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(id)
 	if err != nil {
 		return err
 	}
 
 	d.Set("bucket", bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
 	d.Set("name", name)
 
 	input := &s3.GetBucketAnalyticsConfigurationInput{
@@ -188,6 +200,10 @@ func resourceAwsS3BucketAnalyticsConfigurationRead(d *schema.ResourceData, meta
 		Id:     aws.String(name),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] Reading S3 bucket analytics configuration: %s", input)
 	output, err := conn.GetBucketAnalyticsConfiguration(input)
 
@@ -223,9 +239,10 @@ func resourceAwsS3BucketAnalyticsConfigurationRead(d *schema.ResourceData, meta
 }
 
 func resourceAwsS3BucketAnalyticsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	id, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	conn := meta.(*AWSClient).s3conn
 
-	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(d.Id())
+	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(id)
 	if err != nil {
 		return err
 	}
@@ -235,6 +252,10 @@ func resourceAwsS3BucketAnalyticsConfigurationDelete(d *schema.ResourceData, met
 		Id:     aws.String(name),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] Deleting S3 bucket analytics configuration: %s", input)
 	_, err = conn.DeleteBucketAnalyticsConfiguration(input)
 	if err != nil {
@@ -244,7 +265,7 @@ func resourceAwsS3BucketAnalyticsConfigurationDelete(d *schema.ResourceData, met
 		return fmt.Errorf("Error deleting S3 analytics configuration: %w", err)
 	}
 
-	return waitForDeleteS3BucketAnalyticsConfiguration(conn, bucket, name, 1*time.Minute)
+	return waitForDeleteS3BucketAnalyticsConfiguration(conn, bucket, name, expectedBucketOwner, 1*time.Minute)
 }
 
 func resourceAwsS3BucketAnalyticsConfigurationParseID(id string) (string, string, error) {
@@ -429,12 +450,16 @@ func flattenS3AnalyticsS3BucketDestination(bucketDestination *s3.AnalyticsS3Buck
 	return []interface{}{result}
 }
 
-func waitForDeleteS3BucketAnalyticsConfiguration(conn *s3.S3, bucket, name string, timeout time.Duration) error {
+func waitForDeleteS3BucketAnalyticsConfiguration(conn *s3.S3, bucket, name, expectedBucketOwner string, timeout time.Duration) error {
 	input := &s3.GetBucketAnalyticsConfigurationInput{
 		Bucket: aws.String(bucket),
 		Id:     aws.String(name),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	err := resource.Retry(timeout, func() *resource.RetryError {
 		output, err := conn.GetBucketAnalyticsConfiguration(input)
 
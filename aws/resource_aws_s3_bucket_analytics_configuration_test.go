@@ -465,7 +465,7 @@ func testAccCheckAWSS3BucketAnalyticsConfigurationDestroy(s *terraform.State) er
 			return err
 		}
 
-		return waitForDeleteS3BucketAnalyticsConfiguration(conn, bucket, name, 1*time.Minute)
+		return waitForDeleteS3BucketAnalyticsConfiguration(conn, bucket, name, "", 1*time.Minute)
 
 	}
 	return nil
@@ -501,7 +501,7 @@ func testAccCheckAWSS3BucketAnalyticsConfigurationExists(n string, ac *s3.Analyt
 func testAccCheckAWSS3BucketAnalyticsConfigurationRemoved(name, bucket string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).s3conn
-		return waitForDeleteS3BucketAnalyticsConfiguration(conn, bucket, name, 1*time.Minute)
+		return waitForDeleteS3BucketAnalyticsConfiguration(conn, bucket, name, "", 1*time.Minute)
 	}
 }
 
@@ -0,0 +1,403 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+)
+
+func resourceAwsS3BucketIntelligentTieringConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketIntelligentTieringConfigurationPut,
+		Read:   resourceAwsS3BucketIntelligentTieringConfigurationRead,
+		Update: resourceAwsS3BucketIntelligentTieringConfigurationPut,
+		Delete: resourceAwsS3BucketIntelligentTieringConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: s3BucketIntelligentTieringConfigurationTieringDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							AtLeastOneOf: []string{"filter.0.prefix", "filter.0.tags"},
+						},
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							AtLeastOneOf: []string{"filter.0.prefix", "filter.0.tags"},
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.IntelligentTieringStatusEnabled,
+				ValidateFunc: validation.StringInSlice(s3.IntelligentTieringStatus_Values(), false),
+			},
+			"tiering": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_tier": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.IntelligentTieringAccessTier_Values(), false),
+						},
+						"days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(90, 730),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// s3BucketIntelligentTieringConfigurationTieringDiff returns an error if the days of a tiering
+// are below the minimum of its access tier. The maximum is the same for all tiers.
+func s3BucketIntelligentTieringConfigurationTieringDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	minimumDays := map[string]int{
+		s3.IntelligentTieringAccessTierArchiveAccess:     90,
+		s3.IntelligentTieringAccessTierDeepArchiveAccess: 180,
+	}
+
+	for _, tfMapRaw := range diff.Get("tiering").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		accessTier := tfMap["access_tier"].(string)
+		days := tfMap["days"].(int)
+
+		// Unknown values are read as the zero value.
+		if days == 0 {
+			continue
+		}
+
+		if minimum, ok := minimumDays[accessTier]; ok && days < minimum {
+			return fmt.Errorf("tiering with access_tier %s: days must be between %d and 730, got %d", accessTier, minimum, days)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketIntelligentTieringConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+
+	intelligentTieringConfiguration := &s3.IntelligentTieringConfiguration{
+		Id:     aws.String(name),
+		Status: aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		intelligentTieringConfiguration.Filter = expandS3IntelligentTieringFilter(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("tiering"); ok && v.(*schema.Set).Len() > 0 {
+		intelligentTieringConfiguration.Tierings = expandS3Tierings(v.(*schema.Set).List())
+	}
+
+	input := &s3.PutBucketIntelligentTieringConfigurationInput{
+		Bucket:                          aws.String(bucket),
+		Id:                              aws.String(name),
+		IntelligentTieringConfiguration: intelligentTieringConfiguration,
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Intelligent-Tiering Configuration: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketIntelligentTieringConfiguration(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket Intelligent-Tiering Configuration (%s:%s): %w", bucket, name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
+
+	return resourceAwsS3BucketIntelligentTieringConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketIntelligentTieringConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, name, err := resourceAwsS3BucketIntelligentTieringConfigurationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetBucketIntelligentTieringConfiguration(&s3.GetBucketIntelligentTieringConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchConfiguration)) {
+		log.Printf("[WARN] S3 Bucket Intelligent-Tiering Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Intelligent-Tiering Configuration (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.IntelligentTieringConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket Intelligent-Tiering Configuration (%s): empty response", d.Id())
+	}
+
+	intelligentTieringConfiguration := output.IntelligentTieringConfiguration
+
+	d.Set("bucket", bucket)
+
+	if intelligentTieringConfiguration.Filter != nil {
+		if err := d.Set("filter", []interface{}{flattenS3IntelligentTieringFilter(intelligentTieringConfiguration.Filter)}); err != nil {
+			return fmt.Errorf("error setting filter: %w", err)
+		}
+	} else {
+		d.Set("filter", nil)
+	}
+
+	d.Set("name", intelligentTieringConfiguration.Id)
+	d.Set("status", intelligentTieringConfiguration.Status)
+
+	if err := d.Set("tiering", flattenS3Tierings(intelligentTieringConfiguration.Tierings)); err != nil {
+		return fmt.Errorf("error setting tiering: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketIntelligentTieringConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, name, err := resourceAwsS3BucketIntelligentTieringConfigurationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Intelligent-Tiering Configuration: (%s)", d.Id())
+	_, err = conn.DeleteBucketIntelligentTieringConfiguration(&s3.DeleteBucketIntelligentTieringConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket Intelligent-Tiering Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketIntelligentTieringConfigurationParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, ":")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET:NAME", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func expandS3IntelligentTieringFilter(tfMap map[string]interface{}) *s3.IntelligentTieringFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	var prefix string
+
+	if v, ok := tfMap["prefix"].(string); ok {
+		prefix = v
+	}
+
+	var tags []*s3.Tag
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+		tags = keyvaluetags.New(v).IgnoreAws().S3Tags()
+	}
+
+	if prefix == "" && len(tags) == 0 {
+		return nil
+	}
+
+	apiObject := &s3.IntelligentTieringFilter{}
+
+	if prefix == "" {
+		switch len(tags) {
+		case 1:
+			apiObject.Tag = tags[0]
+		default:
+			apiObject.And = &s3.IntelligentTieringAndOperator{
+				Tags: tags,
+			}
+		}
+	} else {
+		switch len(tags) {
+		case 0:
+			apiObject.Prefix = aws.String(prefix)
+		default:
+			apiObject.And = &s3.IntelligentTieringAndOperator{
+				Prefix: aws.String(prefix),
+				Tags:   tags,
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3Tiering(tfMap map[string]interface{}) *s3.Tiering {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.Tiering{}
+
+	if v, ok := tfMap["access_tier"].(string); ok && v != "" {
+		apiObject.AccessTier = aws.String(v)
+	}
+
+	if v, ok := tfMap["days"].(int); ok && v != 0 {
+		apiObject.Days = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandS3Tierings(tfList []interface{}) []*s3.Tiering {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*s3.Tiering
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandS3Tiering(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenS3IntelligentTieringFilter(apiObject *s3.IntelligentTieringFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.And == nil {
+		if v := apiObject.Prefix; v != nil {
+			tfMap["prefix"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Tag; v != nil {
+			tfMap["tags"] = keyvaluetags.S3KeyValueTags([]*s3.Tag{v}).IgnoreAws().Map()
+		}
+	} else {
+		apiObject := apiObject.And
+
+		if v := apiObject.Prefix; v != nil {
+			tfMap["prefix"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Tags; v != nil {
+			tfMap["tags"] = keyvaluetags.S3KeyValueTags(v).IgnoreAws().Map()
+		}
+	}
+
+	return tfMap
+}
+
+func flattenS3Tiering(apiObject *s3.Tiering) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AccessTier; v != nil {
+		tfMap["access_tier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Days; v != nil {
+		tfMap["days"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3Tierings(apiObjects []*s3.Tiering) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenS3Tiering(apiObject))
+	}
+
+	return tfList
+}
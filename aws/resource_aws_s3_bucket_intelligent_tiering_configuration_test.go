@@ -0,0 +1,362 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+)
+
+func TestAccAWSS3BucketIntelligentTieringConfiguration_basic(t *testing.T) {
+	var itc s3.IntelligentTieringConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketIntelligentTieringConfigurationConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", bucketResourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", s3.IntelligentTieringStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": s3.IntelligentTieringAccessTierDeepArchiveAccess,
+						"days":        "180",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketIntelligentTieringConfiguration_disappears(t *testing.T) {
+	var itc s3.IntelligentTieringConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketIntelligentTieringConfigurationConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3BucketIntelligentTieringConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketIntelligentTieringConfiguration_TieringDays(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketIntelligentTieringConfigurationConfigTieringDays(rName, s3.IntelligentTieringAccessTierArchiveAccess, 89),
+				ExpectError: regexp.MustCompile(`expected .*days to be in the range \(90 - 730\)`),
+			},
+			{
+				Config:      testAccAWSS3BucketIntelligentTieringConfigurationConfigTieringDays(rName, s3.IntelligentTieringAccessTierDeepArchiveAccess, 179),
+				ExpectError: regexp.MustCompile(`days must be between 180 and 730, got 179`),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketIntelligentTieringConfiguration_Filter(t *testing.T) {
+	var itc s3.IntelligentTieringConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterPrefix(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", bucketResourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "p1/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", s3.IntelligentTieringStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": s3.IntelligentTieringAccessTierArchiveAccess,
+						"days":        "90",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterSingleTag(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.Environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3.IntelligentTieringStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": s3.IntelligentTieringAccessTierArchiveAccess,
+						"days":        "90",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": s3.IntelligentTieringAccessTierDeepArchiveAccess,
+						"days":        "180",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterPrefixAndTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "p2/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.Environment", "acctest"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.Forever", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3.IntelligentTieringStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(n string, v *s3.IntelligentTieringConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Intelligent-Tiering Configuration ID is set")
+		}
+
+		bucket, name, err := resourceAwsS3BucketIntelligentTieringConfigurationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		output, err := conn.GetBucketIntelligentTieringConfiguration(&s3.GetBucketIntelligentTieringConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.IntelligentTieringConfiguration == nil {
+			return fmt.Errorf("S3 Bucket Intelligent-Tiering Configuration (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output.IntelligentTieringConfiguration
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketIntelligentTieringConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_intelligent_tiering_configuration" {
+			continue
+		}
+
+		bucket, name, err := resourceAwsS3BucketIntelligentTieringConfigurationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetBucketIntelligentTieringConfiguration(&s3.GetBucketIntelligentTieringConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchConfiguration) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket Intelligent-Tiering Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSS3BucketIntelligentTieringConfigurationConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSS3BucketIntelligentTieringConfigurationConfigTieringDays(rName, accessTier string, days int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  tiering {
+    access_tier = %[2]q
+    days        = %[3]d
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName, accessTier, days)
+}
+
+func testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterPrefix(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  filter {
+    prefix = "p1/"
+  }
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = 90
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterSingleTag(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+  status = "Disabled"
+
+  filter {
+    tags = {
+      Environment = "test"
+    }
+  }
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = 90
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterPrefixAndTags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  filter {
+    prefix = "p2/"
+
+    tags = {
+      Environment = "acctest"
+      Forever     = "false"
+    }
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
@@ -32,6 +32,12 @@ func resourceAwsS3BucketInventory() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -186,8 +192,9 @@ func resourceAwsS3BucketInventory() *schema.Resource {
 }
 
 func resourceAwsS3BucketInventoryPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	conn := meta.(*AWSClient).s3conn
 	name := d.Get("name").(string)
 
 	inventoryConfiguration := &s3.InventoryConfiguration{
@@ -234,6 +241,10 @@ func resourceAwsS3BucketInventoryPut(d *schema.ResourceData, meta interface{}) e
 		InventoryConfiguration: inventoryConfiguration,
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] Putting S3 bucket inventory configuration: %s", input)
 	err := resource.Retry(waiter.PropagationTimeout, func() *resource.RetryError {
 		_, err := conn.PutBucketInventoryConfiguration(input)
@@ -257,15 +268,16 @@ func resourceAwsS3BucketInventoryPut(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("error putting S3 Bucket Inventory Configuration: %w", err)
 	}
 
-	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
+	d.SetId(tfs3.CreateResourceID(fmt.Sprintf("%s:%s", bucket, name), expectedBucketOwner))
 
 	return resourceAwsS3BucketInventoryRead(d, meta)
 }
 
 func resourceAwsS3BucketInventoryDelete(d *schema.ResourceData, meta interface{}) error {
+	id, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	conn := meta.(*AWSClient).s3conn
 
-	bucket, name, err := resourceAwsS3BucketInventoryParseID(d.Id())
+	bucket, name, err := resourceAwsS3BucketInventoryParseID(id)
 	if err != nil {
 		return err
 	}
@@ -275,6 +287,10 @@ func resourceAwsS3BucketInventoryDelete(d *schema.ResourceData, meta interface{}
 		Id:     aws.String(name),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] Deleting S3 bucket inventory configuration: %s", input)
 	_, err = conn.DeleteBucketInventoryConfiguration(input)
 
@@ -294,14 +310,16 @@ func resourceAwsS3BucketInventoryDelete(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsS3BucketInventoryRead(d *schema.ResourceData, meta interface{}) error {
+	id, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	conn := meta.(*AWSClient).s3conn
 
-	bucket, name, err := resourceAwsS3BucketInventoryParseID(d.Id())
+	bucket, name, err := resourceAwsS3BucketInventoryParseID(id)
 	if err != nil {
 		return err
 	}
 
 	d.Set("bucket", bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
 	d.Set("name", name)
 
 	input := &s3.GetBucketInventoryConfigurationInput{
@@ -309,6 +327,10 @@ func resourceAwsS3BucketInventoryRead(d *schema.ResourceData, meta interface{})
 		Id:     aws.String(name),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] Reading S3 bucket inventory configuration: %s", input)
 	var output *s3.GetBucketInventoryConfigurationOutput
 	err = resource.Retry(waiter.PropagationTimeout, func() *resource.RetryError {
@@ -0,0 +1,786 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+)
+
+func resourceAwsS3BucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketLifecycleConfigurationCreate,
+		Read:   resourceAwsS3BucketLifecycleConfigurationRead,
+		Update: resourceAwsS3BucketLifecycleConfigurationUpdate,
+		Delete: resourceAwsS3BucketLifecycleConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: s3BucketLifecycleConfigurationRuleFilterDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_incomplete_multipart_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateS3BucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"and": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"object_size_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"object_size_less_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"tags": tagsSchema(),
+											},
+										},
+									},
+									"object_size_greater_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"object_size_less_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"tag": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+									},
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+									},
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateS3BucketLifecycleTransitionStorageClass(),
+									},
+								},
+							},
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ExpirationStatus_Values(), false),
+						},
+						"transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateS3BucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateS3BucketLifecycleTransitionStorageClass(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: expandS3BucketLifecycleConfigurationRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketLifecycleConfiguration(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Lifecycle Configuration: %w", bucket, err)
+	}
+
+	d.SetId(tfs3.CreateResourceID(bucket, expectedBucketOwner))
+
+	return resourceAwsS3BucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketLifecycleConfiguration(input)
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchLifecycleConfiguration)) {
+		log.Printf("[WARN] S3 Bucket Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Lifecycle Configuration: %w", bucket, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Lifecycle Configuration: empty response", bucket)
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
+
+	if err := d.Set("rule", flattenS3BucketLifecycleConfigurationRules(output.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: expandS3BucketLifecycleConfigurationRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := conn.PutBucketLifecycleConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Lifecycle Configuration: %w", bucket, err)
+	}
+
+	return resourceAwsS3BucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := conn.DeleteBucketLifecycle(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchLifecycleConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Lifecycle Configuration: %w", bucket, err)
+	}
+
+	return nil
+}
+
+func expandS3BucketLifecycleConfigurationRules(tfList []interface{}) []*s3.LifecycleRule {
+	var apiObjects []*s3.LifecycleRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.LifecycleRule{}
+
+		if v, ok := tfMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(v[0].(map[string]interface{})["days_after_initiation"].(int))),
+			}
+		}
+
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Expiration = expandS3BucketLifecycleExpiration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Filter = expandS3BucketLifecycleRuleFilter(v)
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.ID = aws.String(v)
+		}
+
+		if v, ok := tfMap["noncurrent_version_expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.NoncurrentVersionExpiration = expandS3BucketLifecycleNoncurrentVersionExpiration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["noncurrent_version_transition"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.NoncurrentVersionTransitions = expandS3BucketLifecycleNoncurrentVersionTransitions(v.List())
+		}
+
+		// Prefix is deprecated in favor of filter but must be sent when no filter is configured.
+		if v, ok := tfMap["prefix"].(string); ok && apiObject.Filter == nil {
+			apiObject.Prefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["status"].(string); ok && v != "" {
+			apiObject.Status = aws.String(v)
+		}
+
+		if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Transitions = expandS3BucketLifecycleTransitions(v.List())
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3BucketLifecycleExpiration(tfMap map[string]interface{}) *s3.LifecycleExpiration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.LifecycleExpiration{}
+
+	if v, ok := tfMap["date"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+		apiObject.Date = aws.Time(t)
+	}
+
+	if v, ok := tfMap["days"].(int); ok && v > 0 {
+		apiObject.Days = aws.Int64(int64(v))
+	}
+
+	// ExpiredObjectDeleteMarker cannot be specified with Days or Date.
+	if v, ok := tfMap["expired_object_delete_marker"].(bool); ok && v && apiObject.Date == nil && apiObject.Days == nil {
+		apiObject.ExpiredObjectDeleteMarker = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandS3BucketLifecycleNoncurrentVersionExpiration(tfMap map[string]interface{}) *s3.NoncurrentVersionExpiration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.NoncurrentVersionExpiration{}
+
+	if v, ok := tfMap["newer_noncurrent_versions"].(int); ok && v > 0 {
+		apiObject.NewerNoncurrentVersions = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["noncurrent_days"].(int); ok {
+		apiObject.NoncurrentDays = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+// s3BucketLifecycleConfigurationRuleFilterDiff returns an error if a rule filter combines conditions
+// outside of the "and" block, as S3 applies only one of the top-level filter conditions.
+// ConflictsWith cannot express this for the elements of the "rule" list.
+func s3BucketLifecycleConfigurationRuleFilterDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for i, ruleRaw := range diff.Get("rule").([]interface{}) {
+		rule, ok := ruleRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		filters, ok := rule["filter"].([]interface{})
+
+		if !ok || len(filters) == 0 || filters[0] == nil {
+			continue
+		}
+
+		filter := filters[0].(map[string]interface{})
+
+		var conditions []string
+
+		if v, ok := filter["and"].([]interface{}); ok && len(v) > 0 {
+			conditions = append(conditions, "and")
+		}
+
+		if v, ok := filter["object_size_greater_than"].(int); ok && v > 0 {
+			conditions = append(conditions, "object_size_greater_than")
+		}
+
+		if v, ok := filter["object_size_less_than"].(int); ok && v > 0 {
+			conditions = append(conditions, "object_size_less_than")
+		}
+
+		if v, ok := filter["prefix"].(string); ok && v != "" {
+			conditions = append(conditions, "prefix")
+		}
+
+		if v, ok := filter["tag"].([]interface{}); ok && len(v) > 0 {
+			conditions = append(conditions, "tag")
+		}
+
+		if len(conditions) > 1 {
+			return fmt.Errorf("rule.%d.filter: only one of `and`, `object_size_greater_than`, `object_size_less_than`, `prefix` or `tag` can be specified, got %s: combine the conditions in an `and` block instead", i, strings.Join(conditions, ", "))
+		}
+	}
+
+	return nil
+}
+
+func expandS3BucketLifecycleRuleFilter(tfList []interface{}) *s3.LifecycleRuleFilter {
+	apiObject := &s3.LifecycleRuleFilter{}
+
+	// An empty filter block applies the rule to all objects in the bucket.
+	if len(tfList) == 0 || tfList[0] == nil {
+		apiObject.Prefix = aws.String("")
+
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.And = &s3.LifecycleRuleAndOperator{}
+
+		if v, ok := tfMap["object_size_greater_than"].(int); ok && v > 0 {
+			apiObject.And.ObjectSizeGreaterThan = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["object_size_less_than"].(int); ok && v > 0 {
+			apiObject.And.ObjectSizeLessThan = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			apiObject.And.Prefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.And.Tags = keyvaluetags.New(v).IgnoreAws().S3Tags()
+		}
+
+		return apiObject
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Tag = &s3.Tag{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		}
+
+		return apiObject
+	}
+
+	if v, ok := tfMap["object_size_greater_than"].(int); ok && v > 0 {
+		apiObject.ObjectSizeGreaterThan = aws.Int64(int64(v))
+
+		return apiObject
+	}
+
+	if v, ok := tfMap["object_size_less_than"].(int); ok && v > 0 {
+		apiObject.ObjectSizeLessThan = aws.Int64(int64(v))
+
+		return apiObject
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3BucketLifecycleNoncurrentVersionTransitions(tfList []interface{}) []*s3.NoncurrentVersionTransition {
+	var apiObjects []*s3.NoncurrentVersionTransition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.NoncurrentVersionTransition{}
+
+		if v, ok := tfMap["newer_noncurrent_versions"].(int); ok && v > 0 {
+			apiObject.NewerNoncurrentVersions = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["noncurrent_days"].(int); ok {
+			apiObject.NoncurrentDays = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+			apiObject.StorageClass = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3BucketLifecycleTransitions(tfList []interface{}) []*s3.Transition {
+	var apiObjects []*s3.Transition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.Transition{}
+
+		if v, ok := tfMap["date"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+			apiObject.Date = aws.Time(t)
+		} else if v, ok := tfMap["days"].(int); ok {
+			apiObject.Days = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+			apiObject.StorageClass = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenS3BucketLifecycleConfigurationRules(apiObjects []*s3.LifecycleRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id":     aws.StringValue(apiObject.ID),
+			"prefix": aws.StringValue(apiObject.Prefix),
+			"status": aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.AbortIncompleteMultipartUpload; v != nil {
+			tfMap["abort_incomplete_multipart_upload"] = []interface{}{
+				map[string]interface{}{
+					"days_after_initiation": aws.Int64Value(v.DaysAfterInitiation),
+				},
+			}
+		}
+
+		if v := apiObject.Expiration; v != nil {
+			tfMap["expiration"] = []interface{}{flattenS3BucketLifecycleExpiration(v)}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = flattenS3BucketLifecycleRuleFilter(v)
+		}
+
+		if v := apiObject.NoncurrentVersionExpiration; v != nil {
+			tfMap["noncurrent_version_expiration"] = []interface{}{
+				map[string]interface{}{
+					"newer_noncurrent_versions": aws.Int64Value(v.NewerNoncurrentVersions),
+					"noncurrent_days":           aws.Int64Value(v.NoncurrentDays),
+				},
+			}
+		}
+
+		if v := apiObject.NoncurrentVersionTransitions; len(v) > 0 {
+			tfMap["noncurrent_version_transition"] = flattenS3BucketLifecycleNoncurrentVersionTransitions(v)
+		}
+
+		if v := apiObject.Transitions; len(v) > 0 {
+			tfMap["transition"] = flattenS3BucketLifecycleTransitions(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenS3BucketLifecycleExpiration(apiObject *s3.LifecycleExpiration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Date; v != nil {
+		tfMap["date"] = v.Format("2006-01-02")
+	}
+
+	if v := apiObject.Days; v != nil {
+		tfMap["days"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ExpiredObjectDeleteMarker; v != nil {
+		tfMap["expired_object_delete_marker"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3BucketLifecycleRuleFilter(apiObject *s3.LifecycleRuleFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = []interface{}{
+			map[string]interface{}{
+				"object_size_greater_than": aws.Int64Value(v.ObjectSizeGreaterThan),
+				"object_size_less_than":    aws.Int64Value(v.ObjectSizeLessThan),
+				"prefix":                   aws.StringValue(v.Prefix),
+				"tags":                     keyvaluetags.S3KeyValueTags(v.Tags).IgnoreAws().Map(),
+			},
+		}
+	}
+
+	if v := apiObject.ObjectSizeGreaterThan; v != nil {
+		tfMap["object_size_greater_than"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ObjectSizeLessThan; v != nil {
+		tfMap["object_size_less_than"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Tag; v != nil {
+		tfMap["tag"] = []interface{}{
+			map[string]interface{}{
+				"key":   aws.StringValue(v.Key),
+				"value": aws.StringValue(v.Value),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3BucketLifecycleNoncurrentVersionTransitions(apiObjects []*s3.NoncurrentVersionTransition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"newer_noncurrent_versions": aws.Int64Value(apiObject.NewerNoncurrentVersions),
+			"noncurrent_days":           aws.Int64Value(apiObject.NoncurrentDays),
+			"storage_class":             aws.StringValue(apiObject.StorageClass),
+		})
+	}
+
+	return tfList
+}
+
+func flattenS3BucketLifecycleTransitions(apiObjects []*s3.Transition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"storage_class": aws.StringValue(apiObject.StorageClass),
+		}
+
+		if v := apiObject.Date; v != nil {
+			tfMap["date"] = v.Format("2006-01-02")
+		}
+
+		if v := apiObject.Days; v != nil {
+			tfMap["days"] = aws.Int64Value(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,497 @@
+package aws
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/private/protocol/xml/xmlutil"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+)
+
+func TestFlattenExpandS3BucketLifecycleConfigurationRules(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fileName string
+	}{
+		{
+			name:     "and filter with object sizes",
+			fileName: "lifecycle-and-object-size.xml",
+		},
+		{
+			name:     "object size filters",
+			fileName: "lifecycle-object-size.xml",
+		},
+		{
+			name:     "newer noncurrent versions",
+			fileName: "lifecycle-newer-noncurrent-versions.xml",
+		},
+		{
+			name:     "tag filter and legacy prefix",
+			fileName: "lifecycle-tag-and-legacy-prefix.xml",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			f, err := os.Open("testdata/service/s3/" + testCase.fileName)
+
+			if err != nil {
+				t.Fatalf("error opening %s: %s", testCase.fileName, err)
+			}
+
+			defer f.Close()
+
+			output := &s3.GetBucketLifecycleConfigurationOutput{}
+
+			if err := xmlutil.UnmarshalXML(output, xml.NewDecoder(f), ""); err != nil {
+				t.Fatalf("error unmarshaling %s: %s", testCase.fileName, err)
+			}
+
+			if len(output.Rules) == 0 {
+				t.Fatalf("no rules in %s", testCase.fileName)
+			}
+
+			d := resourceAwsS3BucketLifecycleConfiguration().TestResourceData()
+
+			if err := d.Set("rule", flattenS3BucketLifecycleConfigurationRules(output.Rules)); err != nil {
+				t.Fatalf("error setting rule: %s", err)
+			}
+
+			got := expandS3BucketLifecycleConfigurationRules(d.Get("rule").([]interface{}))
+
+			if got, want := testAccAWSS3BucketLifecycleConfigurationRulesString(got), testAccAWSS3BucketLifecycleConfigurationRulesString(output.Rules); got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+// testAccAWSS3BucketLifecycleConfigurationRulesString returns a string representation of
+// lifecycle rules that ignores the order of transitions and tags, which are unordered in Terraform.
+func testAccAWSS3BucketLifecycleConfigurationRulesString(rules []*s3.LifecycleRule) string {
+	for _, rule := range rules {
+		sort.Slice(rule.Transitions, func(i, j int) bool {
+			return awsutil.Prettify(rule.Transitions[i]) < awsutil.Prettify(rule.Transitions[j])
+		})
+
+		sort.Slice(rule.NoncurrentVersionTransitions, func(i, j int) bool {
+			return awsutil.Prettify(rule.NoncurrentVersionTransitions[i]) < awsutil.Prettify(rule.NoncurrentVersionTransitions[j])
+		})
+
+		if rule.Filter != nil && rule.Filter.And != nil {
+			sort.Slice(rule.Filter.And.Tags, func(i, j int) bool {
+				return aws.StringValue(rule.Filter.And.Tags[i].Key) < aws.StringValue(rule.Filter.And.Tags[j].Key)
+			})
+		}
+	}
+
+	return awsutil.Prettify(rules)
+}
+
+func TestAccAWSS3BucketLifecycleConfiguration_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLifecycleConfigurationConfig(rName, 365),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLifecycleConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.status", s3.ExpirationStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.0.days", "365"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transition.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.0.transition.*", map[string]string{
+						"days":          "30",
+						"storage_class": s3.TransitionStorageClassStandardIa,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketLifecycleConfigurationConfig(rName, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLifecycleConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.0.days", "90"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketLifecycleConfiguration_Filter_And(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLifecycleConfigurationConfigFilterAnd(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLifecycleConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.0.and.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.0.and.0.prefix", "tmp/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.0.and.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.noncurrent_version_expiration.0.noncurrent_days", "90"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.abort_incomplete_multipart_upload.0.days_after_initiation", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketLifecycleConfiguration_Filter_ObjectSize(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLifecycleConfigurationConfigFilterObjectSize(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLifecycleConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.0.object_size_greater_than", "1048576"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.filter.0.and.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.filter.0.and.0.object_size_greater_than", "500"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.filter.0.and.0.object_size_less_than", "64000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketLifecycleConfiguration_Filter_Conflicts(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketLifecycleConfigurationConfigFilterConflicts(rName),
+				ExpectError: regexp.MustCompile(`only one of .* can be specified, got object_size_greater_than, prefix`),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketLifecycleConfiguration_NewerNoncurrentVersions(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLifecycleConfigurationConfigNewerNoncurrentVersions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLifecycleConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.noncurrent_version_expiration.0.newer_noncurrent_versions", "10"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.noncurrent_version_transition.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.0.noncurrent_version_transition.*", map[string]string{
+						"newer_noncurrent_versions": "5",
+						"noncurrent_days":           "30",
+						"storage_class":             s3.TransitionStorageClassStandardIa,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketLifecycleConfiguration_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLifecycleConfigurationConfig(rName, 365),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLifecycleConfigurationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3BucketLifecycleConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketLifecycleConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_lifecycle_configuration" {
+			continue
+		}
+
+		_, err := conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchLifecycleConfiguration) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket Lifecycle Configuration (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3BucketLifecycleConfigurationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSS3BucketLifecycleConfigurationConfig(rName string, expirationDays int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {
+      prefix = "logs/"
+    }
+
+    expiration {
+      days = %[2]d
+    }
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+  }
+}
+`, rName, expirationDays)
+}
+
+func testAccAWSS3BucketLifecycleConfigurationConfigFilterAnd(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {
+      and {
+        prefix = "tmp/"
+
+        tags = {
+          Key1 = "Value1"
+          Key2 = "Value2"
+        }
+      }
+    }
+
+    abort_incomplete_multipart_upload {
+      days_after_initiation = 7
+    }
+
+    noncurrent_version_expiration {
+      noncurrent_days = 90
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSS3BucketLifecycleConfigurationConfigFilterObjectSize(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = "large-objects"
+    status = "Enabled"
+
+    filter {
+      object_size_greater_than = 1048576
+    }
+
+    transition {
+      days          = 30
+      storage_class = "GLACIER"
+    }
+  }
+
+  rule {
+    id     = "logs"
+    status = "Enabled"
+
+    filter {
+      and {
+        prefix                   = "logs/"
+        object_size_greater_than = 500
+        object_size_less_than    = 64000
+      }
+    }
+
+    expiration {
+      days = 90
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSS3BucketLifecycleConfigurationConfigFilterConflicts(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = "logs"
+    status = "Enabled"
+
+    filter {
+      object_size_greater_than = 500
+      prefix                   = "logs/"
+    }
+
+    expiration {
+      days = 90
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSS3BucketLifecycleConfigurationConfigNewerNoncurrentVersions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket_versioning.test.bucket
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {}
+
+    noncurrent_version_expiration {
+      newer_noncurrent_versions = 10
+      noncurrent_days           = 90
+    }
+
+    noncurrent_version_transition {
+      newer_noncurrent_versions = 5
+      noncurrent_days           = 30
+      storage_class             = "STANDARD_IA"
+    }
+  }
+}
+`, rName)
+}
@@ -32,6 +32,12 @@ func resourceAwsS3BucketMetric() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
 			"filter": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -62,8 +68,9 @@ func resourceAwsS3BucketMetric() *schema.Resource {
 }
 
 func resourceAwsS3BucketMetricPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	conn := meta.(*AWSClient).s3conn
 	name := d.Get("name").(string)
 
 	metricsConfiguration := &s3.MetricsConfiguration{
@@ -83,6 +90,10 @@ func resourceAwsS3BucketMetricPut(d *schema.ResourceData, meta interface{}) erro
 		MetricsConfiguration: metricsConfiguration,
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] Putting S3 Bucket Metrics Configuration: %s", input)
 	err := resource.Retry(waiter.PropagationTimeout, func() *resource.RetryError {
 		_, err := conn.PutBucketMetricsConfiguration(input)
@@ -106,15 +117,16 @@ func resourceAwsS3BucketMetricPut(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error putting S3 Bucket Metrics Configuration: %w", err)
 	}
 
-	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
+	d.SetId(tfs3.CreateResourceID(fmt.Sprintf("%s:%s", bucket, name), expectedBucketOwner))
 
 	return resourceAwsS3BucketMetricRead(d, meta)
 }
 
 func resourceAwsS3BucketMetricDelete(d *schema.ResourceData, meta interface{}) error {
+	id, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	conn := meta.(*AWSClient).s3conn
 
-	bucket, name, err := resourceAwsS3BucketMetricParseID(d.Id())
+	bucket, name, err := resourceAwsS3BucketMetricParseID(id)
 	if err != nil {
 		return err
 	}
@@ -124,6 +136,10 @@ func resourceAwsS3BucketMetricDelete(d *schema.ResourceData, meta interface{}) e
 		Id:     aws.String(name),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] Deleting S3 Bucket Metrics Configuration: %s", input)
 	_, err = conn.DeleteBucketMetricsConfiguration(input)
 
@@ -143,14 +159,16 @@ func resourceAwsS3BucketMetricDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsS3BucketMetricRead(d *schema.ResourceData, meta interface{}) error {
+	id, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	conn := meta.(*AWSClient).s3conn
 
-	bucket, name, err := resourceAwsS3BucketMetricParseID(d.Id())
+	bucket, name, err := resourceAwsS3BucketMetricParseID(id)
 	if err != nil {
 		return err
 	}
 
 	d.Set("bucket", bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
 	d.Set("name", name)
 
 	input := &s3.GetBucketMetricsConfigurationInput{
@@ -158,6 +176,10 @@ func resourceAwsS3BucketMetricRead(d *schema.ResourceData, meta interface{}) err
 		Id:     aws.String(name),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] Reading S3 Bucket Metrics Configuration: %s", input)
 	output, err := conn.GetBucketMetricsConfiguration(input)
 
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/waiter"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
@@ -31,6 +32,12 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
 			"topic": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -131,8 +138,9 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 }
 
 func resourceAwsS3BucketNotificationPut(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	s3conn := meta.(*AWSClient).s3conn
 
 	// TopicNotifications
 	topicNotifications := d.Get("topic").([]interface{})
@@ -308,6 +316,10 @@ func resourceAwsS3BucketNotificationPut(d *schema.ResourceData, meta interface{}
 		NotificationConfiguration: notificationConfiguration,
 	}
 
+	if expectedBucketOwner != "" {
+		i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] S3 bucket: %s, Putting notification: %v", bucket, i)
 	err := resource.Retry(waiter.PropagationTimeout, func() *resource.RetryError {
 		_, err := s3conn.PutBucketNotificationConfiguration(i)
@@ -331,35 +343,47 @@ func resourceAwsS3BucketNotificationPut(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("error putting S3 Bucket Notification Configuration: %w", err)
 	}
 
-	d.SetId(bucket)
+	d.SetId(tfs3.CreateResourceID(bucket, expectedBucketOwner))
 
 	return resourceAwsS3BucketNotificationRead(d, meta)
 }
 
 func resourceAwsS3BucketNotificationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	s3conn := meta.(*AWSClient).s3conn
 
 	i := &s3.PutBucketNotificationConfigurationInput{
-		Bucket:                    aws.String(d.Id()),
+		Bucket:                    aws.String(bucket),
 		NotificationConfiguration: &s3.NotificationConfiguration{},
 	}
 
-	log.Printf("[DEBUG] S3 bucket: %s, Deleting notification: %v", d.Id(), i)
+	if expectedBucketOwner != "" {
+		i.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	log.Printf("[DEBUG] S3 bucket: %s, Deleting notification: %v", bucket, i)
 	_, err := s3conn.PutBucketNotificationConfiguration(i)
 
 	if err != nil {
-		return fmt.Errorf("error deleting S3 Bucket Notification Configuration (%s): %w", d.Id(), err)
+		return fmt.Errorf("error deleting S3 Bucket Notification Configuration (%s): %w", bucket, err)
 	}
 
 	return nil
 }
 
 func resourceAwsS3BucketNotificationRead(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	s3conn := meta.(*AWSClient).s3conn
 
-	notificationConfigs, err := s3conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
-		Bucket: aws.String(d.Id()),
-	})
+	input := &s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	notificationConfigs, err := s3conn.GetBucketNotificationConfiguration(input)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket Notification Configuration (%s) not found, removing from state", d.Id())
@@ -368,30 +392,31 @@ func resourceAwsS3BucketNotificationRead(d *schema.ResourceData, meta interface{
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading S3 Bucket Notification Configuration (%s): %w", d.Id(), err)
+		return fmt.Errorf("error reading S3 Bucket Notification Configuration (%s): %w", bucket, err)
 	}
 
 	if notificationConfigs == nil {
-		return fmt.Errorf("error reading S3 Bucket Notification Configuration (%s): empty response", d.Id())
+		return fmt.Errorf("error reading S3 Bucket Notification Configuration (%s): empty response", bucket)
 	}
 
-	log.Printf("[DEBUG] S3 Bucket: %s, get notification: %v", d.Id(), notificationConfigs)
+	log.Printf("[DEBUG] S3 Bucket: %s, get notification: %v", bucket, notificationConfigs)
 
-	d.Set("bucket", d.Id())
+	d.Set("bucket", bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
 
 	// Topic Notification
 	if err := d.Set("topic", flattenTopicConfigurations(notificationConfigs.TopicConfigurations)); err != nil {
-		return fmt.Errorf("error reading S3 bucket \"%s\" topic notification: %s", d.Id(), err)
+		return fmt.Errorf("error reading S3 bucket \"%s\" topic notification: %s", bucket, err)
 	}
 
 	// SQS Notification
 	if err := d.Set("queue", flattenQueueConfigurations(notificationConfigs.QueueConfigurations)); err != nil {
-		return fmt.Errorf("error reading S3 bucket \"%s\" queue notification: %s", d.Id(), err)
+		return fmt.Errorf("error reading S3 bucket \"%s\" queue notification: %s", bucket, err)
 	}
 
 	// Lambda Notification
 	if err := d.Set("lambda_function", flattenLambdaFunctionConfigurations(notificationConfigs.LambdaFunctionConfigurations)); err != nil {
-		return fmt.Errorf("error reading S3 bucket \"%s\" lambda function notification: %s", d.Id(), err)
+		return fmt.Errorf("error reading S3 bucket \"%s\" lambda function notification: %s", bucket, err)
 	}
 
 	return nil
@@ -3,18 +3,26 @@ package aws
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"log"
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +34,9 @@ import (
 
 const s3BucketObjectCreationTimeout = 2 * time.Minute
 
+// s3ObjectMaxPutObjectSize is the largest object that can be uploaded in a single PutObject request.
+const s3ObjectMaxPutObjectSize = 5 * 1024 * 1024 * 1024
+
 func resourceAwsS3BucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsS3BucketObjectCreate,
@@ -71,6 +82,12 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Optional: true,
 			},
 
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+
 			"content_disposition": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -147,12 +164,12 @@ func resourceAwsS3BucketObject() *schema.Resource {
 
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption and multipart uploads.
+				// The Etag then won't match raw-file MD5; use source_hash instead.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{"kms_key_id"},
+				ConflictsWith: []string{"kms_key_id", "part_size", "upload_concurrency"},
 			},
 
 			"version_id": {
@@ -168,6 +185,13 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Optional: true,
 			},
 
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -192,15 +216,28 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+
 			"source_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
 		},
 	}
 }
 
 func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	s3conn := meta.(*AWSClient).s3conn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
@@ -249,6 +286,10 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		Body:   body,
 	}
 
+	if expectedBucketOwner != "" {
+		putInput.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	if v, ok := d.GetOk("storage_class"); ok {
 		putInput.StorageClass = aws.String(v.(string))
 	}
@@ -257,6 +298,10 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.CacheControl = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		putInput.ChecksumAlgorithm = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("content_type"); ok {
 		putInput.ContentType = aws.String(v.(string))
 	}
@@ -311,7 +356,43 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ObjectLockRetainUntilDate = expandS3ObjectDate(v.(string))
 	}
 
-	if _, err := s3conn.PutObject(putInput); err != nil {
+	var singlePartSize int64
+
+	// An object uploaded in parts has an ETag that is not the MD5 of its data,
+	// so objects with a configured etag are uploaded in a single request.
+	if _, ok := d.GetOk("etag"); ok && body != nil {
+		size, err := body.Seek(0, io.SeekEnd)
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 bucket object (%s) size: %w", key, err)
+		}
+
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("error reading S3 bucket object (%s) size: %w", key, err)
+		}
+
+		if size > s3ObjectMaxPutObjectSize {
+			return fmt.Errorf("S3 bucket object (%s) is larger than 5 GiB and must be uploaded in parts: use source_hash instead of etag", key)
+		}
+
+		singlePartSize = size + 1
+	}
+
+	uploader := s3manager.NewUploaderWithClient(s3conn, func(u *s3manager.Uploader) {
+		if v, ok := d.GetOk("part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+
+		if singlePartSize > u.PartSize {
+			u.PartSize = singlePartSize
+		}
+
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+	})
+
+	if _, err := uploadS3Object(uploader, putInput); err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -324,6 +405,7 @@ func resourceAwsS3BucketObjectCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	s3conn := meta.(*AWSClient).s3conn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
@@ -336,6 +418,10 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 		Key:    aws.String(key),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	var resp *s3.HeadObjectOutput
 
 	err := resource.Retry(s3BucketObjectCreationTimeout, func() *resource.RetryError {
@@ -410,7 +496,7 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 
 	// Retry due to S3 eventual consistency
 	tagsRaw, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return keyvaluetags.S3ObjectListTags(s3conn, bucket, key)
+		return keyvaluetags.S3ObjectListTags(s3conn, bucket, key, expectedBucketOwner)
 	})
 
 	if err != nil {
@@ -442,30 +528,43 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 		return resourceAwsS3BucketObjectPut(d, meta)
 	}
 
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	conn := meta.(*AWSClient).s3conn
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
 	if d.HasChange("acl") {
-		_, err := conn.PutObjectAcl(&s3.PutObjectAclInput{
+		input := &s3.PutObjectAclInput{
 			Bucket: aws.String(bucket),
 			Key:    aws.String(key),
 			ACL:    aws.String(d.Get("acl").(string)),
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		_, err := conn.PutObjectAcl(input)
 		if err != nil {
 			return fmt.Errorf("error putting S3 object ACL: %s", err)
 		}
 	}
 
 	if d.HasChange("object_lock_legal_hold_status") {
-		_, err := conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+		input := &s3.PutObjectLegalHoldInput{
 			Bucket: aws.String(bucket),
 			Key:    aws.String(key),
 			LegalHold: &s3.ObjectLockLegalHold{
 				Status: aws.String(d.Get("object_lock_legal_hold_status").(string)),
 			},
-		})
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		_, err := conn.PutObjectLegalHold(input)
 		if err != nil {
 			return fmt.Errorf("error putting S3 object lock legal hold: %s", err)
 		}
@@ -481,6 +580,10 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 			},
 		}
 
+		if expectedBucketOwner != "" {
+			req.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
 		// Bypass required to lower or clear retain-until date.
 		if d.HasChange("object_lock_retain_until_date") {
 			oraw, nraw := d.GetChange("object_lock_retain_until_date")
@@ -500,7 +603,7 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := keyvaluetags.S3ObjectUpdateTags(conn, bucket, key, o, n); err != nil {
+		if err := keyvaluetags.S3ObjectUpdateTags(conn, bucket, key, expectedBucketOwner, o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
 	}
@@ -509,6 +612,7 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	s3conn := meta.(*AWSClient).s3conn
 
 	bucket := d.Get("bucket").(string)
@@ -520,9 +624,9 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 
 	var err error
 	if _, ok := d.GetOk("version_id"); ok {
-		err = deleteAllS3ObjectVersions(s3conn, bucket, key, d.Get("force_destroy").(bool), false)
+		err = deleteAllS3ObjectVersions(s3conn, bucket, key, expectedBucketOwner, d.Get("force_destroy").(bool), false)
 	} else {
-		err = deleteS3ObjectVersion(s3conn, bucket, key, "", false)
+		err = deleteS3ObjectVersion(s3conn, bucket, key, "", expectedBucketOwner, false)
 	}
 
 	if err != nil {
@@ -553,6 +657,161 @@ func resourceAwsS3BucketObjectSetKMS(d *schema.ResourceData, meta interface{}, s
 	return nil
 }
 
+// uploadS3Object uploads an object described by a PutObject request.
+// Bodies larger than the uploader's part size are uploaded in parts.
+func uploadS3Object(uploader *s3manager.Uploader, putInput *s3.PutObjectInput) (*s3manager.UploadOutput, error) {
+	input := &s3manager.UploadInput{}
+	awsutil.Copy(input, putInput)
+
+	var options []func(*s3manager.Uploader)
+
+	if v := aws.StringValue(putInput.ChecksumAlgorithm); v != "" {
+		checksummer := &s3ObjectChecksummer{
+			algorithm: v,
+			parts:     make(map[int64]string),
+		}
+
+		options = append(options, func(u *s3manager.Uploader) {
+			u.RequestOptions = append(u.RequestOptions, checksummer.requestOption)
+		})
+	}
+
+	if v := putInput.ExpectedBucketOwner; v != nil {
+		options = append(options, func(u *s3manager.Uploader) {
+			u.RequestOptions = append(u.RequestOptions, s3ObjectUploadExpectedBucketOwner(v))
+		})
+	}
+
+	return uploader.Upload(input, options...)
+}
+
+// s3ObjectUploadExpectedBucketOwner returns a request option that sets the expected bucket owner
+// on the part requests of a multipart upload. The upload manager only passes it on to
+// PutObject and CreateMultipartUpload.
+func s3ObjectUploadExpectedBucketOwner(expectedBucketOwner *string) request.Option {
+	return func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *s3.UploadPartInput:
+			params.ExpectedBucketOwner = expectedBucketOwner
+		case *s3.CompleteMultipartUploadInput:
+			params.ExpectedBucketOwner = expectedBucketOwner
+		case *s3.AbortMultipartUploadInput:
+			params.ExpectedBucketOwner = expectedBucketOwner
+		}
+	}
+}
+
+// s3ObjectChecksummer adds checksums to the requests made by an upload.
+// The SDK does not calculate checksums itself and the upload manager does not
+// pass the checksum algorithm on to the parts of a multipart upload.
+type s3ObjectChecksummer struct {
+	algorithm string
+
+	mu    sync.Mutex
+	parts map[int64]string
+}
+
+func (c *s3ObjectChecksummer) requestOption(r *request.Request) {
+	r.Handlers.Build.PushFront(func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *s3.PutObjectInput:
+			checksum, err := c.checksum(params.Body)
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			params.ChecksumAlgorithm = aws.String(c.algorithm)
+			awsutil.Copy(params, c.checksumFields(checksum))
+
+		case *s3.UploadPartInput:
+			checksum, err := c.checksum(params.Body)
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			params.ChecksumAlgorithm = aws.String(c.algorithm)
+			awsutil.Copy(params, c.checksumFields(checksum))
+
+			c.mu.Lock()
+			c.parts[aws.Int64Value(params.PartNumber)] = checksum
+			c.mu.Unlock()
+
+		case *s3.CompleteMultipartUploadInput:
+			if params.MultipartUpload == nil {
+				return
+			}
+
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			for _, part := range params.MultipartUpload.Parts {
+				awsutil.Copy(part, c.checksumFields(c.parts[aws.Int64Value(part.PartNumber)]))
+			}
+		}
+	})
+}
+
+// checksum returns the base64 encoded checksum of the unread portion of body.
+func (c *s3ObjectChecksummer) checksum(body io.ReadSeeker) (string, error) {
+	var h hash.Hash
+
+	switch c.algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		h = crc32.NewIEEE()
+	case s3.ChecksumAlgorithmCrc32c:
+		h = crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	case s3.ChecksumAlgorithmSha1:
+		h = sha1.New()
+	case s3.ChecksumAlgorithmSha256:
+		h = sha256.New()
+	default:
+		return "", fmt.Errorf("unsupported checksum algorithm: %s", c.algorithm)
+	}
+
+	if body == nil {
+		return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+	}
+
+	offset, err := body.Seek(0, io.SeekCurrent)
+
+	if err != nil {
+		return "", fmt.Errorf("error calculating %s checksum: %w", c.algorithm, err)
+	}
+
+	if _, err := io.Copy(h, body); err != nil {
+		return "", fmt.Errorf("error calculating %s checksum: %w", c.algorithm, err)
+	}
+
+	if _, err := body.Seek(offset, io.SeekStart); err != nil {
+		return "", fmt.Errorf("error calculating %s checksum: %w", c.algorithm, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// checksumFields returns a structure with only the checksum field for the algorithm set.
+// PutObjectInput, UploadPartInput and CompletedPart share these field names.
+func (c *s3ObjectChecksummer) checksumFields(checksum string) *s3.CompletedPart {
+	fields := &s3.CompletedPart{}
+
+	switch c.algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		fields.ChecksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		fields.ChecksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		fields.ChecksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		fields.ChecksumSHA256 = aws.String(checksum)
+	}
+
+	return fields
+}
+
 func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(map[string]interface{})
 
@@ -582,6 +841,7 @@ func hasS3BucketObjectContentChanges(d resourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -607,13 +867,16 @@ func hasS3BucketObjectContentChanges(d resourceDiffer) bool {
 // deleteAllS3ObjectVersions deletes all versions of a specified key from an S3 bucket.
 // If key is empty then all versions of all objects are deleted.
 // Set force to true to override any S3 object lock protections on object lock enabled buckets.
-func deleteAllS3ObjectVersions(conn *s3.S3, bucketName, key string, force, ignoreObjectErrors bool) error {
+func deleteAllS3ObjectVersions(conn *s3.S3, bucketName, key, expectedBucketOwner string, force, ignoreObjectErrors bool) error {
 	input := &s3.ListObjectVersionsInput{
 		Bucket: aws.String(bucketName),
 	}
 	if key != "" {
 		input.Prefix = aws.String(key)
 	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
 
 	var lastErr error
 	err := conn.ListObjectVersionsPages(input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
@@ -629,14 +892,19 @@ func deleteAllS3ObjectVersions(conn *s3.S3, bucketName, key string, force, ignor
 				continue
 			}
 
-			err := deleteS3ObjectVersion(conn, bucketName, objectKey, objectVersionID, force)
+			err := deleteS3ObjectVersion(conn, bucketName, objectKey, objectVersionID, expectedBucketOwner, force)
 			if isAWSErr(err, "AccessDenied", "") && force {
 				// Remove any legal hold.
-				resp, err := conn.HeadObject(&s3.HeadObjectInput{
+				headInput := &s3.HeadObjectInput{
 					Bucket:    aws.String(bucketName),
 					Key:       objectVersion.Key,
 					VersionId: objectVersion.VersionId,
-				})
+				}
+				if expectedBucketOwner != "" {
+					headInput.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+				}
+
+				resp, err := conn.HeadObject(headInput)
 
 				if err != nil {
 					log.Printf("[ERROR] Error getting S3 Bucket (%s) Object (%s) Version (%s) metadata: %s", bucketName, objectKey, objectVersionID, err)
@@ -645,14 +913,19 @@ func deleteAllS3ObjectVersions(conn *s3.S3, bucketName, key string, force, ignor
 				}
 
 				if aws.StringValue(resp.ObjectLockLegalHoldStatus) == s3.ObjectLockLegalHoldStatusOn {
-					_, err := conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+					legalHoldInput := &s3.PutObjectLegalHoldInput{
 						Bucket:    aws.String(bucketName),
 						Key:       objectVersion.Key,
 						VersionId: objectVersion.VersionId,
 						LegalHold: &s3.ObjectLockLegalHold{
 							Status: aws.String(s3.ObjectLockLegalHoldStatusOff),
 						},
-					})
+					}
+					if expectedBucketOwner != "" {
+						legalHoldInput.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+					}
+
+					_, err := conn.PutObjectLegalHold(legalHoldInput)
 
 					if err != nil {
 						log.Printf("[ERROR] Error putting S3 Bucket (%s) Object (%s) Version(%s) legal hold: %s", bucketName, objectKey, objectVersionID, err)
@@ -661,7 +934,7 @@ func deleteAllS3ObjectVersions(conn *s3.S3, bucketName, key string, force, ignor
 					}
 
 					// Attempt to delete again.
-					err = deleteS3ObjectVersion(conn, bucketName, objectKey, objectVersionID, force)
+					err = deleteS3ObjectVersion(conn, bucketName, objectKey, objectVersionID, expectedBucketOwner, force)
 
 					if err != nil {
 						lastErr = err
@@ -713,7 +986,7 @@ func deleteAllS3ObjectVersions(conn *s3.S3, bucketName, key string, force, ignor
 			}
 
 			// Delete markers have no object lock protections.
-			err := deleteS3ObjectVersion(conn, bucketName, deleteMarkerKey, deleteMarkerVersionID, false)
+			err := deleteS3ObjectVersion(conn, bucketName, deleteMarkerKey, deleteMarkerVersionID, expectedBucketOwner, false)
 
 			if err != nil {
 				lastErr = err
@@ -744,7 +1017,7 @@ func deleteAllS3ObjectVersions(conn *s3.S3, bucketName, key string, force, ignor
 
 // deleteS3ObjectVersion deletes a specific bucket object version.
 // Set force to true to override any S3 object lock protections.
-func deleteS3ObjectVersion(conn *s3.S3, b, k, v string, force bool) error {
+func deleteS3ObjectVersion(conn *s3.S3, b, k, v, expectedBucketOwner string, force bool) error {
 	input := &s3.DeleteObjectInput{
 		Bucket: aws.String(b),
 		Key:    aws.String(k),
@@ -754,6 +1027,10 @@ func deleteS3ObjectVersion(conn *s3.S3, b, k, v string, force bool) error {
 		input.VersionId = aws.String(v)
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	if force {
 		input.BypassGovernanceRetention = aws.Bool(true)
 	}
@@ -0,0 +1,768 @@
+package aws
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/go-homedir"
+)
+
+// s3BucketObjectsSyncContentTypes maps file extensions to the Content-Type used
+// when no override applies. The table is consulted before the operating system's
+// MIME database so that plans do not depend on the machine running Terraform.
+var s3BucketObjectsSyncContentTypes = map[string]string{
+	".css":   "text/css; charset=utf-8",
+	".csv":   "text/csv; charset=utf-8",
+	".gif":   "image/gif",
+	".htm":   "text/html; charset=utf-8",
+	".html":  "text/html; charset=utf-8",
+	".ico":   "image/x-icon",
+	".jpeg":  "image/jpeg",
+	".jpg":   "image/jpeg",
+	".js":    "application/javascript",
+	".json":  "application/json",
+	".map":   "application/json",
+	".md":    "text/markdown; charset=utf-8",
+	".mjs":   "application/javascript",
+	".mp4":   "video/mp4",
+	".otf":   "font/otf",
+	".pdf":   "application/pdf",
+	".png":   "image/png",
+	".svg":   "image/svg+xml",
+	".ttf":   "font/ttf",
+	".txt":   "text/plain; charset=utf-8",
+	".wasm":  "application/wasm",
+	".webm":  "video/webm",
+	".webp":  "image/webp",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".xml":   "application/xml",
+	".zip":   "application/zip",
+}
+
+const s3BucketObjectsSyncDefaultContentType = "binary/octet-stream"
+
+func resourceAwsS3BucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketObjectsSyncCreate,
+		Read:   resourceAwsS3BucketObjectsSyncRead,
+		Update: resourceAwsS3BucketObjectsSyncUpdate,
+		Delete: resourceAwsS3BucketObjectsSyncDelete,
+
+		CustomizeDiff: resourceAwsS3BucketObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"bucket_key_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateMetadataIsLowerCase,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"override": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateMetadataIsLowerCase,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+						"pattern": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								if _, err := path.Match(v.(string), ""); err != nil {
+									errors = append(errors, fmt.Errorf("%q (%s) is not a valid pattern: %w", k, v.(string), err))
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+		},
+	}
+}
+
+// s3BucketObjectsSyncFile is a file under source_dir and the object key it is uploaded to.
+type s3BucketObjectsSyncFile struct {
+	hash string
+	key  string
+	path string
+	rel  string
+}
+
+func resourceAwsS3BucketObjectsSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	if err := resourceAwsS3BucketObjectsSyncPut(d, meta); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, keyPrefix))
+
+	return resourceAwsS3BucketObjectsSyncRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	remote, err := listS3BucketObjectsSyncETags(conn, bucket, keyPrefix, expectedBucketOwner)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 Bucket Objects Sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Bucket (%s) Objects: %w", bucket, err)
+	}
+
+	files := expandStringMap(d.Get("files").(map[string]interface{}))
+	etags := expandStringMap(d.Get("etags").(map[string]interface{}))
+
+	// Forget objects that were removed or changed outside of Terraform so that
+	// the next plan uploads them again.
+	for key := range files {
+		if etag, ok := remote[key]; !ok || etag != aws.StringValue(etags[key]) {
+			delete(files, key)
+			delete(etags, key)
+		}
+	}
+
+	// Record extraneous objects with an empty hash so that the next plan removes them.
+	if d.Get("delete_extraneous").(bool) {
+		for key := range remote {
+			if _, ok := files[key]; !ok {
+				files[key] = aws.String("")
+			}
+		}
+	}
+
+	if err := d.Set("etags", aws.StringValueMap(etags)); err != nil {
+		return fmt.Errorf("error setting etags: %w", err)
+	}
+
+	if err := d.Set("files", aws.StringValueMap(files)); err != nil {
+		return fmt.Errorf("error setting files: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAwsS3BucketObjectsSyncPut(d, meta); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketObjectsSyncRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	var keys []string
+	for key := range d.Get("etags").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Objects Sync (%s)", d.Id())
+	if err := deleteS3BucketObjectsSyncKeys(conn, bucket, expectedBucketOwner, keys); err != nil {
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return nil
+		}
+
+		return fmt.Errorf("error deleting S3 Bucket Objects Sync (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsSyncCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Without a prefix, delete_extraneous would delete every other object in the bucket.
+	if diff.Get("delete_extraneous").(bool) && diff.NewValueKnown("key_prefix") && diff.Get("key_prefix").(string) == "" {
+		return fmt.Errorf("key_prefix must be set when delete_extraneous is true")
+	}
+
+	if !diff.NewValueKnown("source_dir") || !diff.NewValueKnown("key_prefix") {
+		if err := diff.SetNewComputed("files"); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("etags")
+	}
+
+	files, err := s3BucketObjectsSyncSourceFiles(diff.Get("source_dir").(string), diff.Get("key_prefix").(string))
+
+	if err != nil {
+		return err
+	}
+
+	n := make(map[string]interface{}, len(files))
+	for _, file := range files {
+		n[file.key] = file.hash
+	}
+
+	o, _ := diff.GetChange("files")
+
+	if !reflect.DeepEqual(o, n) {
+		if err := diff.SetNew("files", n); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("etags")
+	}
+
+	if hasS3BucketObjectsSyncSettingsChanges(diff) {
+		return diff.SetNewComputed("etags")
+	}
+
+	return nil
+}
+
+// resourceAwsS3BucketObjectsSyncPut uploads new and changed files and deletes objects
+// that no longer have a corresponding file, including, with delete_extraneous, any
+// object under key_prefix that was not created by the resource.
+func resourceAwsS3BucketObjectsSyncPut(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	files, err := s3BucketObjectsSyncSourceFiles(d.Get("source_dir").(string), keyPrefix)
+
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("files")
+	oldFiles := o.(map[string]interface{})
+	etags := expandStringMap(d.Get("etags").(map[string]interface{}))
+	uploadAll := d.IsNewResource() || hasS3BucketObjectsSyncSettingsChanges(d)
+
+	var uploads []*s3BucketObjectsSyncFile
+	newFiles := make(map[string]string, len(files))
+
+	for _, file := range files {
+		newFiles[file.key] = file.hash
+
+		if v, ok := oldFiles[file.key]; uploadAll || !ok || v.(string) != file.hash {
+			uploads = append(uploads, file)
+		}
+	}
+
+	deleteKeys := make(map[string]struct{})
+	for key := range oldFiles {
+		deleteKeys[key] = struct{}{}
+	}
+
+	if d.Get("delete_extraneous").(bool) {
+		remote, err := listS3BucketObjectsSyncETags(conn, bucket, keyPrefix, expectedBucketOwner)
+
+		if err != nil {
+			return fmt.Errorf("error listing S3 Bucket (%s) Objects: %w", bucket, err)
+		}
+
+		for key := range remote {
+			deleteKeys[key] = struct{}{}
+		}
+	}
+
+	var deletes []string
+	for key := range deleteKeys {
+		if _, ok := newFiles[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+
+	uploader := s3manager.NewUploaderWithClient(conn)
+	base := expandS3BucketObjectsSyncPutObjectInput(d)
+	overrides := expandS3BucketObjectsSyncOverrides(d.Get("override").([]interface{}))
+	parallelism := d.Get("parallelism").(int)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var errs *multierror.Error
+	sem := make(chan struct{}, parallelism)
+
+	for _, file := range uploads {
+		file := file
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			etag, err := uploadS3BucketObjectsSyncFile(uploader, base, file, overrides)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = multierror.Append(errs, err)
+				return
+			}
+
+			etags[file.key] = aws.String(etag)
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting %d S3 Bucket (%s) Objects", len(deletes), bucket)
+	if err := deleteS3BucketObjectsSyncKeys(conn, bucket, expectedBucketOwner, deletes); err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Objects: %w", bucket, err)
+	}
+
+	for _, key := range deletes {
+		delete(etags, key)
+	}
+
+	if err := d.Set("etags", aws.StringValueMap(etags)); err != nil {
+		return fmt.Errorf("error setting etags: %w", err)
+	}
+
+	if err := d.Set("files", newFiles); err != nil {
+		return fmt.Errorf("error setting files: %w", err)
+	}
+
+	return nil
+}
+
+// expandS3BucketObjectsSyncPutObjectInput returns the upload settings shared by all files.
+// ResourceData is not safe for concurrent use so this is read before uploads start.
+func expandS3BucketObjectsSyncPutObjectInput(d *schema.ResourceData) *s3.PutObjectInput {
+	input := &s3.PutObjectInput{
+		ACL:    aws.String(d.Get("acl").(string)),
+		Bucket: aws.String(d.Get("bucket").(string)),
+	}
+
+	if v, ok := d.GetOk("bucket_key_enabled"); ok {
+		input.BucketKeyEnabled = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("expected_bucket_owner"); ok {
+		input.ExpectedBucketOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		input.Metadata = expandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func uploadS3BucketObjectsSyncFile(uploader *s3manager.Uploader, base *s3.PutObjectInput, file *s3BucketObjectsSyncFile, overrides []*s3BucketObjectsSyncOverride) (string, error) {
+	f, err := os.Open(file.path)
+
+	if err != nil {
+		return "", fmt.Errorf("error opening S3 bucket objects sync source (%s): %w", file.path, err)
+	}
+
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 bucket objects sync source (%s): %s", file.path, err)
+		}
+	}()
+
+	input := &s3.PutObjectInput{}
+	awsutil.Copy(input, base)
+
+	input.Body = f
+	input.ContentType = aws.String(s3BucketObjectsSyncContentType(file.rel))
+	input.Key = aws.String(file.key)
+
+	metadata := make(map[string]*string)
+	for k, v := range input.Metadata {
+		metadata[k] = v
+	}
+
+	for _, override := range overrides {
+		if !override.matches(file.rel) {
+			continue
+		}
+
+		if override.cacheControl != "" {
+			input.CacheControl = aws.String(override.cacheControl)
+		}
+
+		if override.contentType != "" {
+			input.ContentType = aws.String(override.contentType)
+		}
+
+		for k, v := range override.metadata {
+			metadata[k] = v
+		}
+	}
+
+	if len(metadata) > 0 {
+		input.Metadata = metadata
+	}
+
+	bucket := aws.StringValue(input.Bucket)
+
+	log.Printf("[DEBUG] Uploading S3 Bucket (%s) Object (%s) from %s", bucket, file.key, file.path)
+	output, err := uploadS3Object(uploader, input)
+
+	if err != nil {
+		return "", fmt.Errorf("error uploading S3 Bucket (%s) Object (%s): %w", bucket, file.key, err)
+	}
+
+	return strings.Trim(aws.StringValue(output.ETag), `"`), nil
+}
+
+// listS3BucketObjectsSyncETags returns the ETags of the objects under keyPrefix, keyed by object key.
+func listS3BucketObjectsSyncETags(conn *s3.S3, bucket, keyPrefix, expectedBucketOwner string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	etags := make(map[string]string)
+
+	err := conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			etags[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+
+		return !lastPage
+	})
+
+	return etags, err
+}
+
+func deleteS3BucketObjectsSyncKeys(conn *s3.S3, bucket, expectedBucketOwner string, keys []string) error {
+	const maxKeysPerRequest = 1000
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > maxKeysPerRequest {
+			n = maxKeysPerRequest
+		}
+
+		var objects []*s3.ObjectIdentifier
+		for _, key := range keys[:n] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		}
+
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		output, err := conn.DeleteObjects(input)
+
+		if err != nil {
+			return err
+		}
+
+		var errs *multierror.Error
+		for _, v := range output.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("error deleting object (%s): %s: %s", aws.StringValue(v.Key), aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		if err := errs.ErrorOrNil(); err != nil {
+			return err
+		}
+
+		keys = keys[n:]
+	}
+
+	return nil
+}
+
+// s3BucketObjectsSyncSourceFiles returns the regular files under sourceDir, sorted by object key.
+func s3BucketObjectsSyncSourceFiles(sourceDir, keyPrefix string) ([]*s3BucketObjectsSyncFile, error) {
+	root, err := homedir.Expand(sourceDir)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir (%s): %w", sourceDir, err)
+	}
+
+	var files []*s3BucketObjectsSyncFile
+
+	err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+		hash, err := s3BucketObjectsSyncFileHash(p)
+
+		if err != nil {
+			return err
+		}
+
+		files = append(files, &s3BucketObjectsSyncFile{
+			hash: hash,
+			key:  keyPrefix + rel,
+			path: p,
+			rel:  rel,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading S3 bucket objects sync source_dir (%s): %w", sourceDir, err)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].key < files[j].key
+	})
+
+	return files, nil
+}
+
+func s3BucketObjectsSyncFileHash(p string) (string, error) {
+	f, err := os.Open(p)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func s3BucketObjectsSyncContentType(rel string) string {
+	ext := strings.ToLower(path.Ext(rel))
+
+	if v, ok := s3BucketObjectsSyncContentTypes[ext]; ok {
+		return v
+	}
+
+	if v := mime.TypeByExtension(ext); v != "" {
+		return v
+	}
+
+	return s3BucketObjectsSyncDefaultContentType
+}
+
+func hasS3BucketObjectsSyncSettingsChanges(d resourceDiffer) bool {
+	for _, key := range []string{
+		"acl",
+		"bucket_key_enabled",
+		"cache_control",
+		"kms_key_id",
+		"metadata",
+		"override",
+		"server_side_encryption",
+		"storage_class",
+	} {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+type s3BucketObjectsSyncOverride struct {
+	cacheControl string
+	contentType  string
+	metadata     map[string]*string
+	pattern      string
+}
+
+// matches reports whether the override applies to a file. Patterns without a slash
+// are matched against the file's base name, others against its path relative to source_dir.
+func (o *s3BucketObjectsSyncOverride) matches(rel string) bool {
+	name := rel
+	if !strings.Contains(o.pattern, "/") {
+		name = path.Base(rel)
+	}
+
+	ok, _ := path.Match(o.pattern, name)
+
+	return ok
+}
+
+func expandS3BucketObjectsSyncOverrides(tfList []interface{}) []*s3BucketObjectsSyncOverride {
+	var apiObjects []*s3BucketObjectsSyncOverride
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3BucketObjectsSyncOverride{
+			cacheControl: tfMap["cache_control"].(string),
+			contentType:  tfMap["content_type"].(string),
+			metadata:     expandStringMap(tfMap["metadata"].(map[string]interface{})),
+			pattern:      tfMap["pattern"].(string),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,610 @@
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestS3BucketObjectsSyncContentType(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Expected string
+	}{
+		{
+			Name:     "index.html",
+			Expected: "text/html; charset=utf-8",
+		},
+		{
+			Name:     "assets/site.CSS",
+			Expected: "text/css; charset=utf-8",
+		},
+		{
+			Name:     "img/logo.svg",
+			Expected: "image/svg+xml",
+		},
+		{
+			Name:     "LICENSE",
+			Expected: s3BucketObjectsSyncDefaultContentType,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := s3BucketObjectsSyncContentType(testCase.Name)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestS3BucketObjectsSyncOverrideMatches(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		Path     string
+		Expected bool
+	}{
+		{
+			Pattern:  "*.html",
+			Path:     "index.html",
+			Expected: true,
+		},
+		{
+			Pattern:  "*.html",
+			Path:     "docs/guide/index.html",
+			Expected: true,
+		},
+		{
+			Pattern:  "*.html",
+			Path:     "index.htm",
+			Expected: false,
+		},
+		{
+			Pattern:  "assets/*.css",
+			Path:     "assets/site.css",
+			Expected: true,
+		},
+		{
+			Pattern:  "assets/*.css",
+			Path:     "assets/vendor/site.css",
+			Expected: false,
+		},
+		{
+			Pattern:  "assets/*.css",
+			Path:     "site.css",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("%s %s", testCase.Pattern, testCase.Path), func(t *testing.T) {
+			override := &s3BucketObjectsSyncOverride{pattern: testCase.Pattern}
+			got := override.matches(testCase.Path)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestS3BucketObjectsSyncSourceFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	testAccAWSS3BucketObjectsSyncWriteFiles(t, dir, map[string]string{
+		"index.html":      "<h1>hello</h1>",
+		"css/site.css":    "body {}",
+		"img/icons/a.svg": "<svg/>",
+	})
+
+	files, err := s3BucketObjectsSyncSourceFiles(dir, "site/")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []struct {
+		hash string
+		key  string
+		rel  string
+	}{
+		{"fcdce6b6d6e2175f6406869882f6f1ce", "site/css/site.css", "css/site.css"},
+		{"677433a0892aaed7b7d2628c313c9775", "site/img/icons/a.svg", "img/icons/a.svg"},
+		{"a01618fc9b714c0e530f525e1bd6b123", "site/index.html", "index.html"},
+	}
+
+	if got, want := len(files), len(expected); got != want {
+		t.Fatalf("got %d files, expected %d", got, want)
+	}
+
+	for i, file := range files {
+		if file.key != expected[i].key {
+			t.Errorf("file %d: got key %s, expected %s", i, file.key, expected[i].key)
+		}
+
+		if file.rel != expected[i].rel {
+			t.Errorf("file %d: got relative path %s, expected %s", i, file.rel, expected[i].rel)
+		}
+
+		if file.hash != expected[i].hash {
+			t.Errorf("file %d: got hash %s, expected %s", i, file.hash, expected[i].hash)
+		}
+	}
+}
+
+func TestAccAWSS3BucketObjectsSync_basic(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dir := t.TempDir()
+
+	testAccAWSS3BucketObjectsSyncWriteFiles(t, dir, map[string]string{
+		"index.html":   "<h1>hello</h1>",
+		"css/site.css": "body {}",
+		"LICENSE":      "MIT",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/index.html", "a01618fc9b714c0e530f525e1bd6b123"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "site/index.html", &obj),
+					testAccCheckAWSS3BucketObjectsSyncContentType(&obj, "text/html; charset=utf-8"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "site/css/site.css", &obj),
+					testAccCheckAWSS3BucketObjectsSyncContentType(&obj, "text/css; charset=utf-8"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "site/LICENSE", &obj),
+					testAccCheckAWSS3BucketObjectsSyncContentType(&obj, s3BucketObjectsSyncDefaultContentType),
+				),
+			},
+			{
+				Config:   testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_update(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dir := t.TempDir()
+
+	testAccAWSS3BucketObjectsSyncWriteFiles(t, dir, map[string]string{
+		"index.html":   "<h1>hello</h1>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "site/css/site.css", &obj),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccAWSS3BucketObjectsSyncWriteFiles(t, dir, map[string]string{
+						"index.html":  "<h1>goodbye</h1>",
+						"js/site.js":  "console.log('hello')",
+						"favicon.ico": "",
+					})
+
+					if err := os.RemoveAll(filepath.Join(dir, "css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "3"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.site/css/site.css"),
+					testAccCheckAWSS3BucketObjectsSyncObjectNotExists(resourceName, "site/css/site.css"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "site/js/site.js", &obj),
+					testAccCheckAWSS3BucketObjectsSyncContentType(&obj, "application/javascript"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "site/favicon.ico", &obj),
+					testAccCheckAWSS3BucketObjectsSyncContentType(&obj, "image/x-icon"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_override(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dir := t.TempDir()
+
+	testAccAWSS3BucketObjectsSyncWriteFiles(t, dir, map[string]string{
+		"index.html":     "<h1>hello</h1>",
+		"assets/app.css": "body {}",
+		"data.bin":       "\x00\x01",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfigOverride(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "override.#", "3"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "index.html", &obj),
+					testAccCheckAWSS3BucketObjectsSyncCacheControl(&obj, "no-cache"),
+					testAccCheckAWSS3BucketObjectsSyncMetadata(&obj, "team", "web"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "assets/app.css", &obj),
+					testAccCheckAWSS3BucketObjectsSyncCacheControl(&obj, "max-age=31536000"),
+					testAccCheckAWSS3BucketObjectsSyncMetadata(&obj, "team", "assets"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "data.bin", &obj),
+					testAccCheckAWSS3BucketObjectsSyncContentType(&obj, "application/x-custom"),
+					testAccCheckAWSS3BucketObjectsSyncCacheControl(&obj, "max-age=300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_deleteExtraneous(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dir := t.TempDir()
+
+	testAccAWSS3BucketObjectsSyncWriteFiles(t, dir, map[string]string{
+		"index.html": "<h1>hello</h1>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfigDeleteExtraneousBucket(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectsSyncPutExtraneousObject(bucketResourceName, "site/stale.html"),
+					testAccCheckAWSS3BucketObjectsSyncPutExtraneousObject(bucketResourceName, "other/keep.html"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfigDeleteExtraneous(rName, dir, "site/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					testAccCheckAWSS3BucketObjectsSyncObjectNotExists(resourceName, "site/stale.html"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "other/keep.html", &obj),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_deleteExtraneousNoKeyPrefix(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dir := t.TempDir()
+
+	testAccAWSS3BucketObjectsSyncWriteFiles(t, dir, map[string]string{
+		"index.html": "<h1>hello</h1>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketObjectsSyncConfigDeleteExtraneous(rName, dir, ""),
+				ExpectError: regexp.MustCompile(`key_prefix must be set when delete_extraneous is true`),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_kms(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dir := t.TempDir()
+
+	testAccAWSS3BucketObjectsSyncWriteFiles(t, dir, map[string]string{
+		"index.html": "<h1>hello</h1>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfigKms(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "index.html", &obj),
+					func(s *terraform.State) error {
+						if got, want := aws.StringValue(obj.ServerSideEncryption), s3.ServerSideEncryptionAwsKms; got != want {
+							return fmt.Errorf("got server side encryption %s, expected %s", got, want)
+						}
+
+						return resource.TestCheckResourceAttr(kmsKeyResourceName, "arn", aws.StringValue(obj.SSEKMSKeyId))(s)
+					},
+				),
+			},
+			{
+				Config:   testAccAWSS3BucketObjectsSyncConfigKms(rName, dir),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketObjectsSyncDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_objects_sync" {
+			continue
+		}
+
+		for k := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "etags.") || k == "etags.%" {
+				continue
+			}
+
+			key := strings.TrimPrefix(k, "etags.")
+
+			_, err := conn.HeadObject(&s3.HeadObjectInput{
+				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+				Key:    aws.String(key),
+			})
+
+			if err == nil {
+				return fmt.Errorf("S3 Bucket Objects Sync (%s) object %s still exists", rs.Primary.ID, key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObjectExists(n, key string, v *s3.HeadObjectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Objects Sync ID is set")
+		}
+
+		if _, ok := rs.Primary.Attributes["etags."+key]; !ok {
+			return fmt.Errorf("S3 Bucket Objects Sync (%s) does not track object %s", rs.Primary.ID, key)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Bucket Objects Sync (%s) object %s: %w", rs.Primary.ID, key, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObjectNotExists(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err == nil {
+			return fmt.Errorf("S3 Bucket (%s) object %s still exists", rs.Primary.Attributes["bucket"], key)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncPutExtraneousObject(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := conn.PutObject(&s3.PutObjectInput{
+			Body:   strings.NewReader("stale"),
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncCacheControl(obj *s3.HeadObjectOutput, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(obj.CacheControl); got != expected {
+			return fmt.Errorf("got Cache-Control %q, expected %q", got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncContentType(obj *s3.HeadObjectOutput, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(obj.ContentType); got != expected {
+			return fmt.Errorf("got Content-Type %q, expected %q", got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncMetadata(obj *s3.HeadObjectOutput, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// S3 returns user-defined metadata keys in canonical header form.
+		if got := aws.StringValue(obj.Metadata[http.CanonicalHeaderKey(key)]); got != expected {
+			return fmt.Errorf("got metadata %s %q, expected %q", key, got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketObjectsSyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccAWSS3BucketObjectsSyncConfig(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.id
+  key_prefix = "site/"
+  source_dir = %[2]q
+}
+`, rName, dir)
+}
+
+func testAccAWSS3BucketObjectsSyncConfigOverride(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket        = aws_s3_bucket.test.id
+  source_dir    = %[2]q
+  cache_control = "max-age=300"
+
+  metadata = {
+    team = "web"
+  }
+
+  override {
+    pattern       = "*.html"
+    cache_control = "no-cache"
+  }
+
+  override {
+    pattern       = "assets/*"
+    cache_control = "max-age=31536000"
+
+    metadata = {
+      team = "assets"
+    }
+  }
+
+  override {
+    pattern      = "*.bin"
+    content_type = "application/x-custom"
+  }
+}
+`, rName, dir)
+}
+
+func testAccAWSS3BucketObjectsSyncConfigDeleteExtraneousBucket(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccAWSS3BucketObjectsSyncConfigDeleteExtraneous(rName, dir, keyPrefix string) string {
+	return composeConfig(testAccAWSS3BucketObjectsSyncConfigDeleteExtraneousBucket(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket            = aws_s3_bucket.test.id
+  key_prefix        = %[2]q
+  source_dir        = %[1]q
+  delete_extraneous = true
+}
+`, dir, keyPrefix))
+}
+
+func testAccAWSS3BucketObjectsSyncConfigKms(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.id
+  source_dir = %[2]q
+  kms_key_id = aws_kms_key.test.arn
+}
+`, rName, dir)
+}
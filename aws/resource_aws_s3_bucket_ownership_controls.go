@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
 )
 
 func resourceAwsS3BucketOwnershipControls() *schema.Resource {
@@ -29,6 +30,12 @@ func resourceAwsS3BucketOwnershipControls() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
 			"rule": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -49,9 +56,9 @@ func resourceAwsS3BucketOwnershipControls() *schema.Resource {
 }
 
 func resourceAwsS3BucketOwnershipControlsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
-
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	conn := meta.(*AWSClient).s3conn
 
 	input := &s3.PutBucketOwnershipControlsInput{
 		Bucket: aws.String(bucket),
@@ -60,22 +67,31 @@ func resourceAwsS3BucketOwnershipControlsCreate(d *schema.ResourceData, meta int
 		},
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	_, err := conn.PutBucketOwnershipControls(input)
 
 	if err != nil {
 		return fmt.Errorf("error creating S3 Bucket (%s) Ownership Controls: %w", bucket, err)
 	}
 
-	d.SetId(bucket)
+	d.SetId(tfs3.CreateResourceID(bucket, expectedBucketOwner))
 
 	return resourceAwsS3BucketOwnershipControlsRead(d, meta)
 }
 
 func resourceAwsS3BucketOwnershipControlsRead(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	conn := meta.(*AWSClient).s3conn
 
 	input := &s3.GetBucketOwnershipControlsInput{
-		Bucket: aws.String(d.Id()),
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
 	output, err := conn.GetBucketOwnershipControls(input)
@@ -93,14 +109,15 @@ func resourceAwsS3BucketOwnershipControlsRead(d *schema.ResourceData, meta inter
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading S3 Bucket (%s) Ownership Controls: %w", d.Id(), err)
+		return fmt.Errorf("error reading S3 Bucket (%s) Ownership Controls: %w", bucket, err)
 	}
 
 	if output == nil {
-		return fmt.Errorf("error reading S3 Bucket (%s) Ownership Controls: empty response", d.Id())
+		return fmt.Errorf("error reading S3 Bucket (%s) Ownership Controls: empty response", bucket)
 	}
 
-	d.Set("bucket", d.Id())
+	d.Set("bucket", bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
 
 	if output.OwnershipControls == nil {
 		d.Set("rule", nil)
@@ -114,29 +131,39 @@ func resourceAwsS3BucketOwnershipControlsRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsS3BucketOwnershipControlsUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	conn := meta.(*AWSClient).s3conn
 
 	input := &s3.PutBucketOwnershipControlsInput{
-		Bucket: aws.String(d.Id()),
+		Bucket: aws.String(bucket),
 		OwnershipControls: &s3.OwnershipControls{
 			Rules: expandS3OwnershipControlsRules(d.Get("rule").([]interface{})),
 		},
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	_, err := conn.PutBucketOwnershipControls(input)
 
 	if err != nil {
-		return fmt.Errorf("error updating S3 Bucket (%s) Ownership Controls: %w", d.Id(), err)
+		return fmt.Errorf("error updating S3 Bucket (%s) Ownership Controls: %w", bucket, err)
 	}
 
 	return resourceAwsS3BucketOwnershipControlsRead(d, meta)
 }
 
 func resourceAwsS3BucketOwnershipControlsDelete(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	conn := meta.(*AWSClient).s3conn
 
 	input := &s3.DeleteBucketOwnershipControlsInput{
-		Bucket: aws.String(d.Id()),
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
 	_, err := conn.DeleteBucketOwnershipControls(input)
@@ -150,7 +177,7 @@ func resourceAwsS3BucketOwnershipControlsDelete(d *schema.ResourceData, meta int
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting S3 Bucket (%s) Ownership Controls: %w", d.Id(), err)
+		return fmt.Errorf("error deleting S3 Bucket (%s) Ownership Controls: %w", bucket, err)
 	}
 
 	return nil
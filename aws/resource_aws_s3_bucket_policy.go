@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
 )
 
 func resourceAwsS3BucketPolicy() *schema.Resource {
@@ -30,6 +31,12 @@ func resourceAwsS3BucketPolicy() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -41,9 +48,9 @@ func resourceAwsS3BucketPolicy() *schema.Resource {
 }
 
 func resourceAwsS3BucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
-
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	s3conn := meta.(*AWSClient).s3conn
 	policy := d.Get("policy").(string)
 
 	log.Printf("[DEBUG] S3 bucket: %s, put policy: %s", bucket, policy)
@@ -53,6 +60,10 @@ func resourceAwsS3BucketPolicyPut(d *schema.ResourceData, meta interface{}) erro
 		Policy: aws.String(policy),
 	}
 
+	if expectedBucketOwner != "" {
+		params.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
 		_, err := s3conn.PutBucketPolicy(params)
 		if isAWSErr(err, "MalformedPolicy", "") {
@@ -70,18 +81,25 @@ func resourceAwsS3BucketPolicyPut(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error putting S3 policy: %s", err)
 	}
 
-	d.SetId(bucket)
+	d.SetId(tfs3.CreateResourceID(bucket, expectedBucketOwner))
 
 	return nil
 }
 
 func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	s3conn := meta.(*AWSClient).s3conn
 
-	log.Printf("[DEBUG] S3 bucket policy, read for bucket: %s", d.Id())
-	pol, err := s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
-		Bucket: aws.String(d.Id()),
-	})
+	input := &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	log.Printf("[DEBUG] S3 bucket policy, read for bucket: %s", bucket)
+	pol, err := s3conn.GetBucketPolicy(input)
 
 	v := ""
 	if err == nil && pol.Policy != nil {
@@ -90,7 +108,10 @@ func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("policy", v); err != nil {
 		return err
 	}
-	if err := d.Set("bucket", d.Id()); err != nil {
+	if err := d.Set("bucket", bucket); err != nil {
+		return err
+	}
+	if err := d.Set("expected_bucket_owner", expectedBucketOwner); err != nil {
 		return err
 	}
 
@@ -98,14 +119,20 @@ func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsS3BucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	s3conn := meta.(*AWSClient).s3conn
 
-	bucket := d.Get("bucket").(string)
+	input := &s3.DeleteBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
 
 	log.Printf("[DEBUG] S3 bucket: %s, delete policy", bucket)
-	_, err := s3conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
-		Bucket: aws.String(bucket),
-	})
+	_, err := s3conn.DeleteBucketPolicy(input)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucket" {
@@ -44,6 +44,12 @@ func resourceAwsS3BucketPublicAccessBlock() *schema.Resource {
 				Default:  false,
 			},
 
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
 			"ignore_public_acls": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -60,8 +66,9 @@ func resourceAwsS3BucketPublicAccessBlock() *schema.Resource {
 }
 
 func resourceAwsS3BucketPublicAccessBlockCreate(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
 	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	s3conn := meta.(*AWSClient).s3conn
 
 	input := &s3.PutPublicAccessBlockInput{
 		Bucket: aws.String(bucket),
@@ -73,6 +80,10 @@ func resourceAwsS3BucketPublicAccessBlockCreate(d *schema.ResourceData, meta int
 		},
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] S3 bucket: %s, public access block: %v", bucket, input.PublicAccessBlockConfiguration)
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
 		_, err := s3conn.PutPublicAccessBlock(input)
@@ -94,15 +105,20 @@ func resourceAwsS3BucketPublicAccessBlockCreate(d *schema.ResourceData, meta int
 		return fmt.Errorf("error creating public access block policy for S3 bucket (%s): %s", bucket, err)
 	}
 
-	d.SetId(bucket)
+	d.SetId(tfs3.CreateResourceID(bucket, expectedBucketOwner))
 	return resourceAwsS3BucketPublicAccessBlockRead(d, meta)
 }
 
 func resourceAwsS3BucketPublicAccessBlockRead(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	s3conn := meta.(*AWSClient).s3conn
 
 	input := &s3.GetPublicAccessBlockInput{
-		Bucket: aws.String(d.Id()),
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
 	// Retry for eventual consistency on creation
@@ -143,14 +159,15 @@ func resourceAwsS3BucketPublicAccessBlockRead(d *schema.ResourceData, meta inter
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading S3 bucket Public Access Block (%s): %w", d.Id(), err)
+		return fmt.Errorf("error reading S3 bucket Public Access Block (%s): %w", bucket, err)
 	}
 
 	if output == nil || output.PublicAccessBlockConfiguration == nil {
-		return fmt.Errorf("error reading S3 Bucket Public Access Block (%s): empty response", d.Id())
+		return fmt.Errorf("error reading S3 Bucket Public Access Block (%s): empty response", bucket)
 	}
 
-	d.Set("bucket", d.Id())
+	d.Set("bucket", bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
 	d.Set("block_public_acls", output.PublicAccessBlockConfiguration.BlockPublicAcls)
 	d.Set("block_public_policy", output.PublicAccessBlockConfiguration.BlockPublicPolicy)
 	d.Set("ignore_public_acls", output.PublicAccessBlockConfiguration.IgnorePublicAcls)
@@ -160,10 +177,11 @@ func resourceAwsS3BucketPublicAccessBlockRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsS3BucketPublicAccessBlockUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	s3conn := meta.(*AWSClient).s3conn
 
 	input := &s3.PutPublicAccessBlockInput{
-		Bucket: aws.String(d.Id()),
+		Bucket: aws.String(bucket),
 		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
 			BlockPublicAcls:       aws.Bool(d.Get("block_public_acls").(bool)),
 			BlockPublicPolicy:     aws.Bool(d.Get("block_public_policy").(bool)),
@@ -172,6 +190,10 @@ func resourceAwsS3BucketPublicAccessBlockUpdate(d *schema.ResourceData, meta int
 		},
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	log.Printf("[DEBUG] Updating S3 bucket Public Access Block: %s", input)
 	_, err := s3conn.PutPublicAccessBlock(input)
 
@@ -188,7 +210,7 @@ func resourceAwsS3BucketPublicAccessBlockUpdate(d *schema.ResourceData, meta int
 	}
 
 	if err != nil {
-		return fmt.Errorf("error updating S3 Bucket Public Access Block (%s): %s", d.Id(), err)
+		return fmt.Errorf("error updating S3 Bucket Public Access Block (%s): %s", bucket, err)
 	}
 
 	// Workaround API eventual consistency issues. This type of logic should not normally be used.
@@ -205,13 +227,18 @@ func resourceAwsS3BucketPublicAccessBlockUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsS3BucketPublicAccessBlockDelete(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
 	s3conn := meta.(*AWSClient).s3conn
 
 	input := &s3.DeletePublicAccessBlockInput{
-		Bucket: aws.String(d.Id()),
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	log.Printf("[DEBUG] S3 bucket: %s, delete public access block", d.Id())
+	log.Printf("[DEBUG] S3 bucket: %s, delete public access block", bucket)
 	_, err := s3conn.DeletePublicAccessBlock(input)
 
 	if tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchPublicAccessBlockConfiguration) {
@@ -223,7 +250,7 @@ func resourceAwsS3BucketPublicAccessBlockDelete(d *schema.ResourceData, meta int
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting S3 Bucket Public Access Block (%s): %s", d.Id(), err)
+		return fmt.Errorf("error deleting S3 Bucket Public Access Block (%s): %s", bucket, err)
 	}
 
 	return nil
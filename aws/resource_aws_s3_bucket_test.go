@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/waiter"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
 )
 
 func init() {
@@ -227,6 +228,35 @@ func TestAccAWSS3Bucket_Basic_emptyString(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3Bucket_Basic_expectedBucketOwner(t *testing.T) {
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
+	resourceName := "aws_s3_bucket.bucket"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfig_ExpectedBucketOwner(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					testAccCheckResourceAttrAccountID(resourceName, "expected_bucket_owner"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSS3BucketImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "acl"},
+			},
+		},
+	})
+}
+
 func TestAccAWSS3Bucket_Tags_withNoSystemTags(t *testing.T) {
 	resourceName := "aws_s3_bucket.bucket"
 	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
@@ -1446,6 +1476,48 @@ func TestAccAWSS3Bucket_Manage_lifecycleExpireMarkerOnly(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3Bucket_Manage_lifecycleObjectSizeAndNewerNoncurrentVersions(t *testing.T) {
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
+	resourceName := "aws_s3_bucket.bucket"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigWithLifecycleObjectSizeAndNewerNoncurrentVersions(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.id", "id1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.object_size_greater_than", "1048576"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.noncurrent_version_expiration.0.days", "90"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.noncurrent_version_expiration.0.newer_noncurrent_versions", "10"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lifecycle_rule.0.noncurrent_version_transition.*", map[string]string{
+						"days":                      "30",
+						"newer_noncurrent_versions": "5",
+						"storage_class":             "STANDARD_IA",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.1.id", "id2"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.1.prefix", "path2/"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.1.tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.1.tags.Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.1.object_size_greater_than", "500"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.1.object_size_less_than", "64000"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "acl"},
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/11420
 func TestAccAWSS3Bucket_Manage_lifecycleRuleExpirationEmptyConfigurationBlock(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -2296,6 +2368,163 @@ func TestAccAWSS3Bucket_Replication_schemaV2(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3Bucket_Replication_replicationTimeControl(t *testing.T) {
+	rInt := acctest.RandInt()
+	alternateRegion := testAccGetAlternateRegion()
+	region := testAccGetRegion()
+	partition := testAccGetPartition()
+	resourceName := "aws_s3_bucket.bucket"
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ErrorCheck:        testAccErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigReplicationWithReplicationTimeControl(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExistsWithProvider(resourceName, testAccAwsRegionProviderFunc(region, &providers)),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replication_configuration.0.rules.*", map[string]string{
+						"id":                                       "rtc",
+						"priority":                                 "1",
+						"destination.0.metrics.#":                  "1",
+						"destination.0.metrics.0.minutes":          "15",
+						"destination.0.metrics.0.status":           s3.MetricsStatusEnabled,
+						"destination.0.replication_time.#":         "1",
+						"destination.0.replication_time.0.minutes": "15",
+						"destination.0.replication_time.0.status":  s3.ReplicationTimeStatusEnabled,
+						"source_selection_criteria.0.replica_modifications.0.enabled": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replication_configuration.0.rules.*", map[string]string{
+						"id":                                 "existing",
+						"priority":                           "2",
+						"existing_object_replication_status": s3.ExistingObjectReplicationStatusEnabled,
+					}),
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.destination", testAccAwsRegionProviderFunc(alternateRegion, &providers)),
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.destination2", testAccAwsRegionProviderFunc(alternateRegion, &providers)),
+					testAccCheckAWSS3BucketReplicationRules(
+						resourceName,
+						[]*s3.ReplicationRule{
+							{
+								ID: aws.String("rtc"),
+								Destination: &s3.Destination{
+									Bucket:       aws.String(fmt.Sprintf("arn:%s:s3:::tf-test-bucket-destination-%d", partition, rInt)),
+									StorageClass: aws.String(s3.ObjectStorageClassStandard),
+									Metrics: &s3.Metrics{
+										EventThreshold: &s3.ReplicationTimeValue{
+											Minutes: aws.Int64(15),
+										},
+										Status: aws.String(s3.MetricsStatusEnabled),
+									},
+									ReplicationTime: &s3.ReplicationTime{
+										Status: aws.String(s3.ReplicationTimeStatusEnabled),
+										Time: &s3.ReplicationTimeValue{
+											Minutes: aws.Int64(15),
+										},
+									},
+								},
+								SourceSelectionCriteria: &s3.SourceSelectionCriteria{
+									ReplicaModifications: &s3.ReplicaModifications{
+										Status: aws.String(s3.ReplicaModificationsStatusEnabled),
+									},
+								},
+								Status: aws.String(s3.ReplicationRuleStatusEnabled),
+								Filter: &s3.ReplicationRuleFilter{
+									Prefix: aws.String("compliance/"),
+								},
+								Priority: aws.Int64(1),
+								DeleteMarkerReplication: &s3.DeleteMarkerReplication{
+									Status: aws.String(s3.DeleteMarkerReplicationStatusDisabled),
+								},
+							},
+							{
+								ID: aws.String("existing"),
+								Destination: &s3.Destination{
+									Bucket:       aws.String(fmt.Sprintf("arn:%s:s3:::tf-test-bucket-destination2-%d", partition, rInt)),
+									StorageClass: aws.String(s3.ObjectStorageClassStandardIa),
+								},
+								ExistingObjectReplication: &s3.ExistingObjectReplication{
+									Status: aws.String(s3.ExistingObjectReplicationStatusEnabled),
+								},
+								Status: aws.String(s3.ReplicationRuleStatusEnabled),
+								Filter: &s3.ReplicationRuleFilter{
+									Prefix: aws.String(""),
+								},
+								Priority: aws.Int64(2),
+								DeleteMarkerReplication: &s3.DeleteMarkerReplication{
+									Status: aws.String(s3.DeleteMarkerReplicationStatusDisabled),
+								},
+							},
+						},
+					),
+				),
+			},
+			{
+				Config:                  testAccAWSS3BucketConfigReplicationWithReplicationTimeControl(rInt),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "acl"},
+			},
+		},
+	})
+}
+
+func TestAccAWSS3Bucket_Replication_expectReplicationTimeMetricsValidationError(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ErrorCheck:        testAccErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketConfigReplicationWithReplicationTimeNoMetrics(rInt),
+				ExpectError: regexp.MustCompile(`replication_time requires metrics to be enabled`),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3Bucket_Replication_expectDuplicatePriorityValidationError(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ErrorCheck:        testAccErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketConfigReplicationWithDuplicatePriority(rInt),
+				ExpectError: regexp.MustCompile(`have the same priority \(1\)`),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3Bucket_Replication_schemaV2SameRegion(t *testing.T) {
 	resourceName := "aws_s3_bucket.bucket"
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -2574,6 +2803,376 @@ func TestBucketRegionalDomainName(t *testing.T) {
 	}
 }
 
+func TestExpandS3BucketLifecycleRuleFilter(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		TfMap    map[string]interface{}
+		Expected *s3.LifecycleRuleFilter
+	}{
+		{
+			Name:     "empty",
+			TfMap:    map[string]interface{}{},
+			Expected: &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+		},
+		{
+			Name: "prefix",
+			TfMap: map[string]interface{}{
+				"prefix": "path1/",
+			},
+			Expected: &s3.LifecycleRuleFilter{Prefix: aws.String("path1/")},
+		},
+		{
+			Name: "object size greater than",
+			TfMap: map[string]interface{}{
+				"object_size_greater_than": 1048576,
+			},
+			Expected: &s3.LifecycleRuleFilter{ObjectSizeGreaterThan: aws.Int64(1048576)},
+		},
+		{
+			Name: "object size less than",
+			TfMap: map[string]interface{}{
+				"object_size_less_than": 64000,
+			},
+			Expected: &s3.LifecycleRuleFilter{ObjectSizeLessThan: aws.Int64(64000)},
+		},
+		{
+			Name: "tags",
+			TfMap: map[string]interface{}{
+				"tags": map[string]interface{}{"Key1": "Value1"},
+			},
+			Expected: &s3.LifecycleRuleFilter{
+				And: &s3.LifecycleRuleAndOperator{
+					Prefix: aws.String(""),
+					Tags:   []*s3.Tag{{Key: aws.String("Key1"), Value: aws.String("Value1")}},
+				},
+			},
+		},
+		{
+			Name: "prefix and object sizes",
+			TfMap: map[string]interface{}{
+				"prefix":                   "path1/",
+				"object_size_greater_than": 500,
+				"object_size_less_than":    64000,
+			},
+			Expected: &s3.LifecycleRuleFilter{
+				And: &s3.LifecycleRuleAndOperator{
+					ObjectSizeGreaterThan: aws.Int64(500),
+					ObjectSizeLessThan:    aws.Int64(64000),
+					Prefix:                aws.String("path1/"),
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := expandS3BucketLifecycleInlineRuleFilter(testCase.TfMap)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenS3BucketLifecycleRuleFilter(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Filter   *s3.LifecycleRuleFilter
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "nil",
+			Expected: map[string]interface{}{},
+		},
+		{
+			Name:     "empty prefix",
+			Filter:   &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+			Expected: map[string]interface{}{},
+		},
+		{
+			Name:   "prefix",
+			Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("path1/")},
+			Expected: map[string]interface{}{
+				"prefix": "path1/",
+			},
+		},
+		{
+			Name:   "tag",
+			Filter: &s3.LifecycleRuleFilter{Tag: &s3.Tag{Key: aws.String("Key1"), Value: aws.String("Value1")}},
+			Expected: map[string]interface{}{
+				"tags": map[string]string{"Key1": "Value1"},
+			},
+		},
+		{
+			Name:   "object size greater than",
+			Filter: &s3.LifecycleRuleFilter{ObjectSizeGreaterThan: aws.Int64(1048576)},
+			Expected: map[string]interface{}{
+				"object_size_greater_than": 1048576,
+			},
+		},
+		{
+			Name:   "object size less than",
+			Filter: &s3.LifecycleRuleFilter{ObjectSizeLessThan: aws.Int64(64000)},
+			Expected: map[string]interface{}{
+				"object_size_less_than": 64000,
+			},
+		},
+		{
+			Name: "and",
+			Filter: &s3.LifecycleRuleFilter{
+				And: &s3.LifecycleRuleAndOperator{
+					ObjectSizeGreaterThan: aws.Int64(500),
+					ObjectSizeLessThan:    aws.Int64(64000),
+					Prefix:                aws.String("path1/"),
+					Tags:                  []*s3.Tag{{Key: aws.String("Key1"), Value: aws.String("Value1")}},
+				},
+			},
+			Expected: map[string]interface{}{
+				"object_size_greater_than": 500,
+				"object_size_less_than":    64000,
+				"prefix":                   "path1/",
+				"tags":                     map[string]string{"Key1": "Value1"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := make(map[string]interface{})
+			flattenS3BucketLifecycleInlineRuleFilter(got, testCase.Filter)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestValidateS3BucketReplicationRules(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Rules         []*s3.ReplicationRule
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name: "V1 rule",
+			Rules: []*s3.ReplicationRule{
+				{
+					ID:          aws.String("rule1"),
+					Destination: &s3.Destination{},
+					Prefix:      aws.String("foo"),
+				},
+			},
+		},
+		{
+			Name: "V1 rule with replication time",
+			Rules: []*s3.ReplicationRule{
+				{
+					ID: aws.String("rule1"),
+					Destination: &s3.Destination{
+						ReplicationTime: &s3.ReplicationTime{Status: aws.String(s3.ReplicationTimeStatusEnabled)},
+					},
+					Prefix: aws.String("foo"),
+				},
+			},
+			ExpectedError: regexp.MustCompile(`metrics and replication_time require a filter`),
+		},
+		{
+			Name: "V1 rule with existing object replication",
+			Rules: []*s3.ReplicationRule{
+				{
+					ID:                        aws.String("rule1"),
+					Destination:               &s3.Destination{},
+					ExistingObjectReplication: &s3.ExistingObjectReplication{Status: aws.String(s3.ExistingObjectReplicationStatusEnabled)},
+				},
+			},
+			ExpectedError: regexp.MustCompile(`existing_object_replication_status requires a filter`),
+		},
+		{
+			Name: "V1 rule with replica modifications",
+			Rules: []*s3.ReplicationRule{
+				{
+					ID:          aws.String("rule1"),
+					Destination: &s3.Destination{},
+					SourceSelectionCriteria: &s3.SourceSelectionCriteria{
+						ReplicaModifications: &s3.ReplicaModifications{Status: aws.String(s3.ReplicaModificationsStatusEnabled)},
+					},
+				},
+			},
+			ExpectedError: regexp.MustCompile(`replica_modifications requires a filter`),
+		},
+		{
+			Name: "replication time with metrics",
+			Rules: []*s3.ReplicationRule{
+				{
+					ID: aws.String("rule1"),
+					Destination: &s3.Destination{
+						Metrics:         &s3.Metrics{Status: aws.String(s3.MetricsStatusEnabled)},
+						ReplicationTime: &s3.ReplicationTime{Status: aws.String(s3.ReplicationTimeStatusEnabled)},
+					},
+					Filter:   &s3.ReplicationRuleFilter{},
+					Priority: aws.Int64(1),
+				},
+			},
+		},
+		{
+			Name: "replication time without metrics",
+			Rules: []*s3.ReplicationRule{
+				{
+					ID: aws.String("rule1"),
+					Destination: &s3.Destination{
+						ReplicationTime: &s3.ReplicationTime{Status: aws.String(s3.ReplicationTimeStatusEnabled)},
+					},
+					Filter:   &s3.ReplicationRuleFilter{},
+					Priority: aws.Int64(1),
+				},
+			},
+			ExpectedError: regexp.MustCompile(`replication_time requires metrics to be enabled`),
+		},
+		{
+			Name: "replication time with disabled metrics",
+			Rules: []*s3.ReplicationRule{
+				{
+					ID: aws.String("rule1"),
+					Destination: &s3.Destination{
+						Metrics:         &s3.Metrics{Status: aws.String(s3.MetricsStatusDisabled)},
+						ReplicationTime: &s3.ReplicationTime{Status: aws.String(s3.ReplicationTimeStatusEnabled)},
+					},
+					Filter:   &s3.ReplicationRuleFilter{},
+					Priority: aws.Int64(1),
+				},
+			},
+			ExpectedError: regexp.MustCompile(`replication_time requires metrics to be enabled`),
+		},
+		{
+			Name: "distinct priorities",
+			Rules: []*s3.ReplicationRule{
+				{
+					ID:          aws.String("rule1"),
+					Destination: &s3.Destination{},
+					Filter:      &s3.ReplicationRuleFilter{},
+					Priority:    aws.Int64(1),
+				},
+				{
+					ID:          aws.String("rule2"),
+					Destination: &s3.Destination{},
+					Filter:      &s3.ReplicationRuleFilter{},
+					Priority:    aws.Int64(2),
+				},
+			},
+		},
+		{
+			Name: "duplicate priorities",
+			Rules: []*s3.ReplicationRule{
+				{
+					ID:          aws.String("rule1"),
+					Destination: &s3.Destination{},
+					Filter:      &s3.ReplicationRuleFilter{},
+					Priority:    aws.Int64(1),
+				},
+				{
+					ID:          aws.String("rule2"),
+					Destination: &s3.Destination{},
+					Filter:      &s3.ReplicationRuleFilter{},
+					Priority:    aws.Int64(1),
+				},
+			},
+			ExpectedError: regexp.MustCompile(`replication rules \(rule1\) and \(rule2\) have the same priority \(1\)`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validateS3BucketReplicationRules(testCase.Rules, true)
+
+			if testCase.ExpectedError == nil && err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+
+			if testCase.ExpectedError != nil && (err == nil || !testCase.ExpectedError.MatchString(err.Error())) {
+				t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestS3BucketReplicationConfigurationDiff(t *testing.T) {
+	// Value the SDK uses for attributes only known after apply.
+	const unknown = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	resource := &schema.Resource{
+		Schema:        resourceAwsS3Bucket().Schema,
+		CustomizeDiff: s3BucketReplicationConfigurationDiff,
+	}
+
+	rule := func(id string, priority interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"id":       id,
+			"priority": priority,
+			"status":   s3.ReplicationRuleStatusEnabled,
+			"filter": []interface{}{
+				map[string]interface{}{"prefix": id},
+			},
+			"destination": []interface{}{
+				map[string]interface{}{"bucket": "arn:aws:s3:::destination"}, //lintignore:AWSAT005
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name          string
+		Rules         []interface{}
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:  "distinct priorities",
+			Rules: []interface{}{rule("rule1", 1), rule("rule2", 2)},
+		},
+		{
+			Name:          "same priority",
+			Rules:         []interface{}{rule("rule1", 1), rule("rule2", 1)},
+			ExpectedError: regexp.MustCompile(`have the same priority \(1\)`),
+		},
+		{
+			Name:  "unknown priority",
+			Rules: []interface{}{rule("rule1", unknown), rule("rule2", 0)},
+		},
+		{
+			Name:  "unknown priorities",
+			Rules: []interface{}{rule("rule1", unknown), rule("rule2", unknown)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"bucket": "source",
+				"replication_configuration": []interface{}{
+					map[string]interface{}{
+						"role":  "arn:aws:iam::123456789012:role/replication", //lintignore:AWSAT005
+						"rules": testCase.Rules,
+					},
+				},
+			})
+
+			_, err := resource.Diff(context.Background(), nil, config, &AWSClient{})
+
+			if testCase.ExpectedError == nil && err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+
+			if testCase.ExpectedError != nil && (err == nil || !testCase.ExpectedError.MatchString(err.Error())) {
+				t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
 func TestWebsiteEndpoint(t *testing.T) {
 	// https://docs.aws.amazon.com/AmazonS3/latest/dev/WebsiteEndpoints.html
 	testCases := []struct {
@@ -2929,7 +3528,7 @@ func testAccCheckAWSS3BucketTagKeys(n string, keys ...string) resource.TestCheck
 		rs := s.RootModule().Resources[n]
 		conn := testAccProvider.Meta().(*AWSClient).s3conn
 
-		got, err := keyvaluetags.S3BucketListTags(conn, rs.Primary.Attributes["bucket"])
+		got, err := keyvaluetags.S3BucketListTags(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["expected_bucket_owner"])
 		if err != nil {
 			return err
 		}
@@ -3286,7 +3885,7 @@ func testAccCheckAWSS3BucketUpdateTags(n string, oldTags, newTags map[string]str
 		rs := s.RootModule().Resources[n]
 		conn := testAccProvider.Meta().(*AWSClient).s3conn
 
-		return keyvaluetags.S3BucketUpdateTags(conn, rs.Primary.Attributes["bucket"], oldTags, newTags)
+		return keyvaluetags.S3BucketUpdateTags(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["expected_bucket_owner"], oldTags, newTags)
 	}
 }
 
@@ -3295,7 +3894,7 @@ func testAccCheckAWSS3BucketCheckTags(n string, expectedTags map[string]string)
 		rs := s.RootModule().Resources[n]
 		conn := testAccProvider.Meta().(*AWSClient).s3conn
 
-		got, err := keyvaluetags.S3BucketListTags(conn, rs.Primary.Attributes["bucket"])
+		got, err := keyvaluetags.S3BucketListTags(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["expected_bucket_owner"])
 		if err != nil {
 			return err
 		}
@@ -3326,6 +3925,17 @@ func testAccAWSS3BucketPolicy(bucketName, partition string) string {
 }`, partition, bucketName)
 }
 
+func testAccAWSS3BucketImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return tfs3.CreateResourceID(rs.Primary.Attributes["bucket"], rs.Primary.Attributes["expected_bucket_owner"]), nil
+	}
+}
+
 func testAccAWSS3BucketConfig_Basic(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -3334,6 +3944,17 @@ resource "aws_s3_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccAWSS3BucketConfig_ExpectedBucketOwner(bucketName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket                = %[1]q
+  expected_bucket_owner = data.aws_caller_identity.current.account_id
+}
+`, bucketName)
+}
+
 func testAccAWSS3BucketConfig_withNoTags(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -3937,6 +4558,52 @@ resource "aws_s3_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccAWSS3BucketConfigWithLifecycleObjectSizeAndNewerNoncurrentVersions(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = %[1]q
+  acl    = "private"
+
+  versioning {
+    enabled = true
+  }
+
+  lifecycle_rule {
+    id                       = "id1"
+    object_size_greater_than = 1048576
+    enabled                  = true
+
+    noncurrent_version_expiration {
+      days                      = 90
+      newer_noncurrent_versions = 10
+    }
+
+    noncurrent_version_transition {
+      days                      = 30
+      newer_noncurrent_versions = 5
+      storage_class             = "STANDARD_IA"
+    }
+  }
+
+  lifecycle_rule {
+    id                       = "id2"
+    prefix                   = "path2/"
+    object_size_greater_than = 500
+    object_size_less_than    = 64000
+    enabled                  = true
+
+    tags = {
+      Key1 = "Value1"
+    }
+
+    expiration {
+      days = 365
+    }
+  }
+}
+`, bucketName)
+}
+
 func testAccAWSS3BucketConfigWithVersioningLifecycle(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -4320,6 +4987,157 @@ resource "aws_s3_bucket" "bucket" {
 `, randInt))
 }
 
+func testAccAWSS3BucketConfigReplicationWithReplicationTimeControl(randInt int) string {
+	return composeConfig(
+		testAccAWSS3BucketConfigReplicationBasic(randInt),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "destination2" {
+  provider = "awsalternate"
+  bucket   = "tf-test-bucket-destination2-%[1]d"
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%[1]d"
+  acl    = "private"
+
+  versioning {
+    enabled = true
+  }
+
+  replication_configuration {
+    role = aws_iam_role.role.arn
+
+    rules {
+      id       = "rtc"
+      priority = 1
+      status   = "Enabled"
+
+      filter {
+        prefix = "compliance/"
+      }
+
+      source_selection_criteria {
+        replica_modifications {
+          enabled = true
+        }
+      }
+
+      destination {
+        bucket        = aws_s3_bucket.destination.arn
+        storage_class = "STANDARD"
+
+        metrics {
+          minutes = 15
+          status  = "Enabled"
+        }
+
+        replication_time {
+          minutes = 15
+          status  = "Enabled"
+        }
+      }
+    }
+
+    rules {
+      id       = "existing"
+      priority = 2
+      status   = "Enabled"
+
+      existing_object_replication_status = "Enabled"
+
+      filter {}
+
+      destination {
+        bucket        = aws_s3_bucket.destination2.arn
+        storage_class = "STANDARD_IA"
+      }
+    }
+  }
+}
+`, randInt))
+}
+
+func testAccAWSS3BucketConfigReplicationWithReplicationTimeNoMetrics(randInt int) string {
+	return testAccAWSS3BucketConfigReplicationBasic(randInt) + fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%[1]d"
+  acl    = "private"
+
+  versioning {
+    enabled = true
+  }
+
+  replication_configuration {
+    role = aws_iam_role.role.arn
+
+    rules {
+      id     = "rtc"
+      status = "Enabled"
+
+      filter {}
+
+      destination {
+        bucket = aws_s3_bucket.destination.arn
+
+        replication_time {
+          status = "Enabled"
+        }
+      }
+    }
+  }
+}
+`, randInt)
+}
+
+func testAccAWSS3BucketConfigReplicationWithDuplicatePriority(randInt int) string {
+	return testAccAWSS3BucketConfigReplicationBasic(randInt) + fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%[1]d"
+  acl    = "private"
+
+  versioning {
+    enabled = true
+  }
+
+  replication_configuration {
+    role = aws_iam_role.role.arn
+
+    rules {
+      id       = "rule1"
+      priority = 1
+      status   = "Enabled"
+
+      filter {
+        prefix = "prefix1"
+      }
+
+      destination {
+        bucket = aws_s3_bucket.destination.arn
+      }
+    }
+
+    rules {
+      id       = "rule2"
+      priority = 1
+      status   = "Enabled"
+
+      filter {
+        prefix = "prefix2"
+      }
+
+      destination {
+        bucket = aws_s3_bucket.destination.arn
+      }
+    }
+  }
+}
+`, randInt)
+}
+
 func testAccAWSS3BucketConfigReplicationWithSseKmsEncryptedObjects(randInt int) string {
 	return testAccAWSS3BucketConfigReplicationBasic(randInt) + fmt.Sprintf(`
 resource "aws_kms_key" "replica" {
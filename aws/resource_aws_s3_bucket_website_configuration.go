@@ -0,0 +1,364 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+)
+
+func resourceAwsS3BucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketWebsiteConfigurationCreate,
+		Read:   resourceAwsS3BucketWebsiteConfigurationRead,
+		Update: resourceAwsS3BucketWebsiteConfigurationUpdate,
+		Delete: resourceAwsS3BucketWebsiteConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"error_document": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"index_document": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"index_document", "redirect_all_requests_to"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suffix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"redirect_all_requests_to": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"index_document", "redirect_all_requests_to"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(s3.Protocol_Values(), false),
+						},
+					},
+				},
+			},
+			"routing_rules": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+				ValidateFunc:  validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"website_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"website_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketWebsiteConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+	conn := meta.(*AWSClient).s3conn
+
+	websiteConfiguration, err := expandS3BucketWebsiteConfiguration(d)
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucket),
+		WebsiteConfiguration: websiteConfiguration,
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err = retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketWebsite(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Website Configuration: %w", bucket, err)
+	}
+
+	d.SetId(tfs3.CreateResourceID(bucket, expectedBucketOwner))
+
+	return resourceAwsS3BucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	awsClient := meta.(*AWSClient)
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
+	conn := awsClient.s3conn
+
+	input := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketWebsite(input)
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchWebsiteConfiguration)) {
+		log.Printf("[WARN] S3 Bucket Website Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Website Configuration: %w", bucket, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Website Configuration: empty response", bucket)
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
+
+	if err := d.Set("error_document", flattenS3BucketWebsiteErrorDocument(output.ErrorDocument)); err != nil {
+		return fmt.Errorf("error setting error_document: %w", err)
+	}
+
+	if err := d.Set("index_document", flattenS3BucketWebsiteIndexDocument(output.IndexDocument)); err != nil {
+		return fmt.Errorf("error setting index_document: %w", err)
+	}
+
+	if err := d.Set("redirect_all_requests_to", flattenS3BucketWebsiteRedirectAllRequestsTo(output.RedirectAllRequestsTo)); err != nil {
+		return fmt.Errorf("error setting redirect_all_requests_to: %w", err)
+	}
+
+	if len(output.RoutingRules) > 0 {
+		routingRules, err := normalizeRoutingRules(output.RoutingRules)
+
+		if err != nil {
+			return fmt.Errorf("error marshaling S3 Bucket (%s) routing rules: %w", bucket, err)
+		}
+
+		d.Set("routing_rules", routingRules)
+	} else {
+		d.Set("routing_rules", nil)
+	}
+
+	locationInput := &s3.GetBucketLocationInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		locationInput.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	location, err := conn.GetBucketLocation(locationInput)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) location: %w", bucket, err)
+	}
+
+	website := WebsiteEndpoint(awsClient, bucket, aws.StringValue(location.LocationConstraint))
+	d.Set("website_domain", website.Domain)
+	d.Set("website_endpoint", website.Endpoint)
+
+	return nil
+}
+
+func resourceAwsS3BucketWebsiteConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
+	conn := meta.(*AWSClient).s3conn
+
+	websiteConfiguration, err := expandS3BucketWebsiteConfiguration(d)
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucket),
+		WebsiteConfiguration: websiteConfiguration,
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err = conn.PutBucketWebsite(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Website Configuration: %w", bucket, err)
+	}
+
+	return resourceAwsS3BucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucket, expectedBucketOwner := tfs3.ParseResourceID(d.Id())
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := conn.DeleteBucketWebsite(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchWebsiteConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Website Configuration: %w", bucket, err)
+	}
+
+	return nil
+}
+
+func expandS3BucketWebsiteConfiguration(d *schema.ResourceData) (*s3.WebsiteConfiguration, error) {
+	apiObject := &s3.WebsiteConfiguration{}
+
+	if v, ok := d.GetOk("error_document"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.ErrorDocument = &s3.ErrorDocument{
+			Key: aws.String(tfMap["key"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("index_document"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.IndexDocument = &s3.IndexDocument{
+			Suffix: aws.String(tfMap["suffix"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("redirect_all_requests_to"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{
+			HostName: aws.String(tfMap["host_name"].(string)),
+		}
+
+		if v, ok := tfMap["protocol"].(string); ok && v != "" {
+			apiObject.RedirectAllRequestsTo.Protocol = aws.String(v)
+		}
+	}
+
+	if v, ok := d.GetOk("routing_rules"); ok {
+		var routingRules []*s3.RoutingRule
+
+		if err := json.Unmarshal([]byte(v.(string)), &routingRules); err != nil {
+			return nil, fmt.Errorf("error unmarshaling routing_rules: %w", err)
+		}
+
+		apiObject.RoutingRules = routingRules
+	}
+
+	return apiObject, nil
+}
+
+func flattenS3BucketWebsiteErrorDocument(apiObject *s3.ErrorDocument) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Key; v != nil {
+		tfMap["key"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3BucketWebsiteIndexDocument(apiObject *s3.IndexDocument) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Suffix; v != nil {
+		tfMap["suffix"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3BucketWebsiteRedirectAllRequestsTo(apiObject *s3.RedirectAllRequestsTo) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.HostName; v != nil {
+		tfMap["host_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Protocol; v != nil {
+		tfMap["protocol"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
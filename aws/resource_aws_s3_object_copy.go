@@ -292,6 +292,7 @@ func resourceAwsS3ObjectCopyCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsS3ObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	s3conn := meta.(*AWSClient).s3conn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
@@ -299,11 +300,16 @@ func resourceAwsS3ObjectCopyRead(d *schema.ResourceData, meta interface{}) error
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	resp, err := s3conn.HeadObject(
-		&s3.HeadObjectInput{
-			Bucket: aws.String(bucket),
-			Key:    aws.String(key),
-		})
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	resp, err := s3conn.HeadObject(input)
 
 	if !d.IsNewResource() && tfawserr.ErrStatusCodeEquals(err, 404) {
 		log.Printf("[WARN] S3 Object (%s) not found, removing from state", d.Id())
@@ -361,7 +367,7 @@ func resourceAwsS3ObjectCopyRead(d *schema.ResourceData, meta interface{}) error
 
 	// Retry due to S3 eventual consistency
 	tagsRaw, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return keyvaluetags.S3ObjectListTags(s3conn, bucket, key)
+		return keyvaluetags.S3ObjectListTags(s3conn, bucket, key, expectedBucketOwner)
 	})
 
 	if err != nil {
@@ -445,6 +451,7 @@ func resourceAwsS3ObjectCopyUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsS3ObjectCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	s3conn := meta.(*AWSClient).s3conn
 
 	bucket := d.Get("bucket").(string)
@@ -456,9 +463,9 @@ func resourceAwsS3ObjectCopyDelete(d *schema.ResourceData, meta interface{}) err
 
 	var err error
 	if _, ok := d.GetOk("version_id"); ok {
-		err = deleteAllS3ObjectVersions(s3conn, bucket, key, d.Get("force_destroy").(bool), false)
+		err = deleteAllS3ObjectVersions(s3conn, bucket, key, expectedBucketOwner, d.Get("force_destroy").(bool), false)
 	} else {
-		err = deleteS3ObjectVersion(s3conn, bucket, key, "", false)
+		err = deleteS3ObjectVersion(s3conn, bucket, key, "", expectedBucketOwner, false)
 	}
 
 	if err != nil {
@@ -468,6 +475,7 @@ func resourceAwsS3ObjectCopyDelete(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsS3ObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) error {
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	conn := meta.(*AWSClient).s3conn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
@@ -478,6 +486,10 @@ func resourceAwsS3ObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) err
 		CopySource: aws.String(url.QueryEscape(d.Get("source").(string))),
 	}
 
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
 	if v, ok := d.GetOk("acl"); ok {
 		input.ACL = aws.String(v.(string))
 	}
@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3ControlAccessPointPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ControlAccessPointPolicyCreate,
+		Read:   resourceAwsS3ControlAccessPointPolicyRead,
+		Update: resourceAwsS3ControlAccessPointPolicyUpdate,
+		Delete: resourceAwsS3ControlAccessPointPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsS3ControlAccessPointPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_point_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"has_public_access_policy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsS3ControlAccessPointPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	resourceID, err := s3AccessPointCreateResourceIDFromARN(d.Get("access_point_arn").(string))
+
+	if err != nil {
+		return err
+	}
+
+	accountID, name, err := s3AccessPointParseId(resourceID)
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3control.PutAccessPointPolicyInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+		Policy:    aws.String(d.Get("policy").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating S3 Access Point Policy: %s", input)
+	_, err = conn.PutAccessPointPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Access Point (%s) Policy: %w", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return resourceAwsS3ControlAccessPointPolicyRead(d, meta)
+}
+
+func resourceAwsS3ControlAccessPointPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID, name, err := s3AccessPointParseId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	policy, status, err := finder.AccessPointPolicyAndStatusByAccountIDAndName(conn, accountID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Point Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("has_public_access_policy", status.IsPublic)
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceAwsS3ControlAccessPointPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID, name, err := s3AccessPointParseId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3control.PutAccessPointPolicyInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+		Policy:    aws.String(d.Get("policy").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating S3 Access Point Policy: %s", input)
+	_, err = conn.PutAccessPointPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsS3ControlAccessPointPolicyRead(d, meta)
+}
+
+func resourceAwsS3ControlAccessPointPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID, name, err := s3AccessPointParseId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Access Point Policy: %s", d.Id())
+	_, err = conn.DeleteAccessPointPolicy(&s3control.DeleteAccessPointPolicyInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPoint) || tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPointPolicy) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3ControlAccessPointPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceID, err := s3AccessPointCreateResourceIDFromARN(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("access_point_arn", d.Id())
+	d.SetId(resourceID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// s3AccessPointCreateResourceIDFromARN returns the S3 Access Point resource ID
+// (ACCOUNT_ID:NAME for S3, the ARN itself for S3 on Outposts) for an Access Point ARN.
+func s3AccessPointCreateResourceIDFromARN(accessPointARN string) (string, error) {
+	v, err := arn.Parse(accessPointARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing S3 Access Point ARN (%s): %w", accessPointARN, err)
+	}
+
+	if strings.HasPrefix(v.Resource, "outpost/") {
+		return accessPointARN, nil
+	}
+
+	name := strings.TrimPrefix(v.Resource, "accesspoint/")
+
+	if name == v.Resource || name == "" {
+		return "", fmt.Errorf("unexpected format for S3 Access Point ARN (%s)", accessPointARN)
+	}
+
+	return fmt.Sprintf("%s:%s", v.AccountID, name), nil
+}
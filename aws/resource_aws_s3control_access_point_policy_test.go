@@ -0,0 +1,272 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSS3ControlAccessPointPolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3control_access_point_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlAccessPointPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlAccessPointPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlAccessPointPolicyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "access_point_arn", "aws_s3_access_point.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "has_public_access_policy", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSS3ControlAccessPointPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlAccessPointPolicy_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3control_access_point_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlAccessPointPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlAccessPointPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlAccessPointPolicyExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3ControlAccessPointPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlAccessPointPolicy_disappears_AccessPoint(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3control_access_point_policy.test"
+	accessPointResourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlAccessPointPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlAccessPointPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlAccessPointPolicyExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3AccessPoint(), accessPointResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlAccessPointPolicy_update(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3control_access_point_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlAccessPointPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlAccessPointPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlAccessPointPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "has_public_access_policy", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSS3ControlAccessPointPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3ControlAccessPointPolicyConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlAccessPointPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "has_public_access_policy", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSS3ControlAccessPointPolicyImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["access_point_arn"], nil
+	}
+}
+
+func testAccCheckAWSS3ControlAccessPointPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_access_point_policy" {
+			continue
+		}
+
+		accountID, name, err := s3AccessPointParseId(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, _, err = finder.AccessPointPolicyAndStatusByAccountIDAndName(conn, accountID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Access Point Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3ControlAccessPointPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Access Point Policy ID is set")
+		}
+
+		accountID, name, err := s3AccessPointParseId(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+		_, _, err = finder.AccessPointPolicyAndStatusByAccountIDAndName(conn, accountID, name)
+
+		return err
+	}
+}
+
+func testAccAWSS3ControlAccessPointPolicyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+
+  public_access_block_configuration {
+    block_public_acls       = true
+    block_public_policy     = false
+    ignore_public_acls      = true
+    restrict_public_buckets = false
+  }
+
+  lifecycle {
+    ignore_changes = [policy]
+  }
+}
+
+resource "aws_s3control_access_point_policy" "test" {
+  access_point_arn = aws_s3_access_point.test.arn
+
+  policy = jsonencode({
+    Version = "2008-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "s3:GetObjectTagging"
+      Principal = {
+        AWS = "*"
+      }
+      Resource = "${aws_s3_access_point.test.arn}/object/*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccAWSS3ControlAccessPointPolicyConfigUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+
+  public_access_block_configuration {
+    block_public_acls       = true
+    block_public_policy     = false
+    ignore_public_acls      = true
+    restrict_public_buckets = false
+  }
+
+  lifecycle {
+    ignore_changes = [policy]
+  }
+}
+
+resource "aws_s3control_access_point_policy" "test" {
+  access_point_arn = aws_s3_access_point.test.arn
+
+  policy = jsonencode({
+    Version = "2008-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetObjectLegalHold",
+        "s3:GetObjectRetention"
+      ]
+      Principal = {
+        AWS = "*"
+      }
+      Resource = "${aws_s3_access_point.test.arn}/object/*"
+    }]
+  })
+}
+`, rName)
+}
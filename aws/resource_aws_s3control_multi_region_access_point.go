@@ -0,0 +1,389 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3ControlMultiRegionAccessPoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ControlMultiRegionAccessPointCreate,
+		Read:   resourceAwsS3ControlMultiRegionAccessPointRead,
+		Delete: resourceAwsS3ControlMultiRegionAccessPointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"details": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(3, 50),
+								validation.StringMatch(regexp.MustCompile(`^[a-z0-9][a-z0-9-]*[a-z0-9]$`), "must contain only lowercase letters, numbers, and hyphens, and must begin and end with a letter or number"),
+							),
+						},
+						"public_access_block": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							ForceNew:         true,
+							MinItems:         0,
+							MaxItems:         1,
+							DiffSuppressFunc: suppressMissingOptionalConfigurationBlock,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_public_acls": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+										ForceNew: true,
+									},
+									"block_public_policy": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+										ForceNew: true,
+									},
+									"ignore_public_acls": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+										ForceNew: true,
+									},
+									"restrict_public_buckets": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"region": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 20,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(3, 255),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3ControlMultiRegionAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := s3ControlMultiRegionAccessPointConn(meta)
+
+	if err != nil {
+		return err
+	}
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateMultiRegionAccessPointInput{
+		AccountId:   aws.String(accountID),
+		ClientToken: aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Details = expandS3ControlCreateMultiRegionAccessPointInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	resourceID := tfs3control.MultiRegionAccessPointCreateResourceID(accountID, aws.StringValue(input.Details.Name))
+
+	log.Printf("[DEBUG] Creating S3 Multi-Region Access Point: %s", input)
+	output, err := conn.CreateMultiRegionAccessPoint(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Multi-Region Access Point (%s): %w", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	if _, err := waiter.MultiRegionAccessPointRequestSucceeded(conn, accountID, aws.StringValue(output.RequestTokenARN), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for S3 Multi-Region Access Point (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsS3ControlMultiRegionAccessPointRead(d, meta)
+}
+
+func resourceAwsS3ControlMultiRegionAccessPointRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := s3ControlMultiRegionAccessPointConn(meta)
+
+	if err != nil {
+		return err
+	}
+
+	accountID, name, err := tfs3control.MultiRegionAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	accessPoint, err := finder.MultiRegionAccessPointByAccountIDAndName(conn, accountID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Multi-Region Access Point (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Multi-Region Access Point (%s): %w", d.Id(), err)
+	}
+
+	alias := aws.StringValue(accessPoint.Alias)
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/MultiRegionAccessPointRequests.html.
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "s3",
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("accesspoint/%s", alias),
+	}.String()
+
+	d.Set("account_id", accountID)
+	d.Set("alias", alias)
+	d.Set("arn", arn)
+	if err := d.Set("details", []interface{}{flattenS3ControlMultiRegionAccessPointReport(accessPoint)}); err != nil {
+		return fmt.Errorf("error setting details: %w", err)
+	}
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/MultiRegionAccessPointRequests.html#MultiRegionAccessPointHostnames.
+	d.Set("domain_name", fmt.Sprintf("%s.accesspoint.s3-global.%s", alias, meta.(*AWSClient).dnsSuffix))
+	d.Set("status", accessPoint.Status)
+
+	return nil
+}
+
+func resourceAwsS3ControlMultiRegionAccessPointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := s3ControlMultiRegionAccessPointConn(meta)
+
+	if err != nil {
+		return err
+	}
+
+	accountID, name, err := tfs3control.MultiRegionAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Multi-Region Access Point: %s", d.Id())
+	output, err := conn.DeleteMultiRegionAccessPoint(&s3control.DeleteMultiRegionAccessPointInput{
+		AccountId:   aws.String(accountID),
+		ClientToken: aws.String(resource.UniqueId()),
+		Details: &s3control.DeleteMultiRegionAccessPointInput_{
+			Name: aws.String(name),
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchMultiRegionAccessPoint) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Multi-Region Access Point (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.MultiRegionAccessPointRequestSucceeded(conn, accountID, aws.StringValue(output.RequestTokenARN), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for S3 Multi-Region Access Point (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// s3ControlMultiRegionAccessPointConn returns the S3 Control client for Multi-Region Access Point requests,
+// which must be routed to the US West (Oregon) Region.
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/MultiRegionAccessPointRestrictions.html.
+func s3ControlMultiRegionAccessPointConn(meta interface{}) (*s3control.S3Control, error) {
+	client, err := meta.(*AWSClient).RegionalClient(endpoints.UsWest2RegionID)
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring AWS client for S3 Multi-Region Access Points: %w", err)
+	}
+
+	return client.s3controlconn, nil
+}
+
+func expandS3ControlCreateMultiRegionAccessPointInput(tfMap map[string]interface{}) *s3control.CreateMultiRegionAccessPointInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.CreateMultiRegionAccessPointInput_{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["public_access_block"].([]interface{}); ok {
+		apiObject.PublicAccessBlock = expandS3AccessPointPublicAccessBlockConfiguration(v)
+	}
+
+	if v, ok := tfMap["region"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Regions = expandS3ControlRegions(v.List())
+	}
+
+	return apiObject
+}
+
+func expandS3ControlRegion(tfMap map[string]interface{}) *s3control.Region {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.Region{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3ControlRegions(tfList []interface{}) []*s3control.Region {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*s3control.Region
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandS3ControlRegion(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenS3ControlMultiRegionAccessPointReport(apiObject *s3control.MultiRegionAccessPointReport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PublicAccessBlock; v != nil {
+		tfMap["public_access_block"] = flattenS3AccessPointPublicAccessBlockConfiguration(v)
+	}
+
+	if v := apiObject.Regions; v != nil {
+		tfMap["region"] = flattenS3ControlRegionReports(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3ControlRegionReport(apiObject *s3control.RegionReport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bucket; v != nil {
+		tfMap["bucket"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3ControlRegionReports(apiObjects []*s3control.RegionReport) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenS3ControlRegionReport(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3ControlMultiRegionAccessPointPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ControlMultiRegionAccessPointPolicyCreate,
+		Read:   resourceAwsS3ControlMultiRegionAccessPointPolicyRead,
+		Update: resourceAwsS3ControlMultiRegionAccessPointPolicyUpdate,
+		Delete: resourceAwsS3ControlMultiRegionAccessPointPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"details": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"policy": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+						},
+					},
+				},
+			},
+			"established": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"proposed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3ControlMultiRegionAccessPointPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := s3ControlMultiRegionAccessPointConn(meta)
+
+	if err != nil {
+		return err
+	}
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.PutMultiRegionAccessPointPolicyInput{
+		AccountId:   aws.String(accountID),
+		ClientToken: aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Details = expandS3ControlPutMultiRegionAccessPointPolicyInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	resourceID := tfs3control.MultiRegionAccessPointCreateResourceID(accountID, aws.StringValue(input.Details.Name))
+
+	log.Printf("[DEBUG] Creating S3 Multi-Region Access Point Policy: %s", input)
+	output, err := conn.PutMultiRegionAccessPointPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Multi-Region Access Point (%s) Policy: %w", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	if _, err := waiter.MultiRegionAccessPointRequestSucceeded(conn, accountID, aws.StringValue(output.RequestTokenARN), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for S3 Multi-Region Access Point Policy (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsS3ControlMultiRegionAccessPointPolicyRead(d, meta)
+}
+
+func resourceAwsS3ControlMultiRegionAccessPointPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := s3ControlMultiRegionAccessPointConn(meta)
+
+	if err != nil {
+		return err
+	}
+
+	accountID, name, err := tfs3control.MultiRegionAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	policyDocument, err := finder.MultiRegionAccessPointPolicyDocumentByAccountIDAndName(conn, accountID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Multi-Region Access Point Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Multi-Region Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+
+	var established, proposed string
+
+	if v := policyDocument.Established; v != nil {
+		established = aws.StringValue(v.Policy)
+	}
+
+	if v := policyDocument.Proposed; v != nil {
+		proposed = aws.StringValue(v.Policy)
+	}
+
+	d.Set("established", established)
+	d.Set("proposed", proposed)
+
+	// The proposed policy is the one most recently put, which may not yet be established.
+	details := map[string]interface{}{
+		"name":   name,
+		"policy": proposed,
+	}
+
+	if err := d.Set("details", []interface{}{details}); err != nil {
+		return fmt.Errorf("error setting details: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3ControlMultiRegionAccessPointPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := s3ControlMultiRegionAccessPointConn(meta)
+
+	if err != nil {
+		return err
+	}
+
+	accountID, _, err := tfs3control.MultiRegionAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3control.PutMultiRegionAccessPointPolicyInput{
+		AccountId:   aws.String(accountID),
+		ClientToken: aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Details = expandS3ControlPutMultiRegionAccessPointPolicyInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating S3 Multi-Region Access Point Policy: %s", input)
+	output, err := conn.PutMultiRegionAccessPointPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Multi-Region Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.MultiRegionAccessPointRequestSucceeded(conn, accountID, aws.StringValue(output.RequestTokenARN), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for S3 Multi-Region Access Point Policy (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceAwsS3ControlMultiRegionAccessPointPolicyRead(d, meta)
+}
+
+func resourceAwsS3ControlMultiRegionAccessPointPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is no API to delete a Multi-Region Access Point policy,
+	// it is removed along with the Multi-Region Access Point.
+	log.Printf("[WARN] S3 Multi-Region Access Point Policy (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func expandS3ControlPutMultiRegionAccessPointPolicyInput(tfMap map[string]interface{}) *s3control.PutMultiRegionAccessPointPolicyInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.PutMultiRegionAccessPointPolicyInput_{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["policy"].(string); ok && v != "" {
+		apiObject.Policy = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+)
+
+func TestAccAWSS3ControlMultiRegionAccessPointPolicy_basic(t *testing.T) {
+	var v s3control.MultiRegionAccessPointPolicyDocument
+	resourceName := "aws_s3control_multi_region_access_point_policy.test"
+	bucketName := acctest.RandomWithPrefix("tf-acc-test")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlMultiRegionAccessPointPolicyConfig(bucketName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlMultiRegionAccessPointPolicyExists(resourceName, &v),
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "details.0.policy"),
+					resource.TestCheckResourceAttrSet(resourceName, "proposed"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlMultiRegionAccessPointPolicy_disappears_MultiRegionAccessPoint(t *testing.T) {
+	var v s3control.MultiRegionAccessPointPolicyDocument
+	resourceName := "aws_s3control_multi_region_access_point_policy.test"
+	accessPointResourceName := "aws_s3control_multi_region_access_point.test"
+	bucketName := acctest.RandomWithPrefix("tf-acc-test")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlMultiRegionAccessPointPolicyConfig(bucketName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlMultiRegionAccessPointPolicyExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3ControlMultiRegionAccessPoint(), accessPointResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlMultiRegionAccessPointPolicy_update(t *testing.T) {
+	var v s3control.MultiRegionAccessPointPolicyDocument
+	resourceName := "aws_s3control_multi_region_access_point_policy.test"
+	bucketName := acctest.RandomWithPrefix("tf-acc-test")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlMultiRegionAccessPointPolicyConfig(bucketName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlMultiRegionAccessPointPolicyExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "details.0.policy", regexp.MustCompile(`s3:GetObject`)),
+				),
+			},
+			{
+				Config: testAccAWSS3ControlMultiRegionAccessPointPolicyConfigUpdated(bucketName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlMultiRegionAccessPointPolicyExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "details.0.policy", regexp.MustCompile(`s3:PutObject`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ControlMultiRegionAccessPointPolicyExists(n string, v *s3control.MultiRegionAccessPointPolicyDocument) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Multi-Region Access Point Policy ID is set")
+		}
+
+		accountID, name, err := tfs3control.MultiRegionAccessPointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn, err := s3ControlMultiRegionAccessPointConn(testAccProvider.Meta())
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.MultiRegionAccessPointPolicyDocumentByAccountIDAndName(conn, accountID, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSS3ControlMultiRegionAccessPointPolicyConfig(bucketName, rName string) string {
+	return composeConfig(testAccAWSS3ControlMultiRegionAccessPointConfig(bucketName, rName), `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3control_multi_region_access_point_policy" "test" {
+  details {
+    name   = aws_s3control_multi_region_access_point.test.details[0].name
+    policy = jsonencode({
+      "Version" : "2012-10-17",
+      "Statement" : [
+        {
+          "Sid" : "Test",
+          "Effect" : "Allow",
+          "Principal" : {
+            "AWS" : data.aws_caller_identity.current.account_id
+          },
+          "Action" : "s3:GetObject",
+          "Resource" : "arn:${data.aws_partition.current.partition}:s3::${data.aws_caller_identity.current.account_id}:accesspoint/${aws_s3control_multi_region_access_point.test.alias}/object/*"
+        }
+      ]
+    })
+  }
+}
+`)
+}
+
+func testAccAWSS3ControlMultiRegionAccessPointPolicyConfigUpdated(bucketName, rName string) string {
+	return composeConfig(testAccAWSS3ControlMultiRegionAccessPointConfig(bucketName, rName), `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3control_multi_region_access_point_policy" "test" {
+  details {
+    name   = aws_s3control_multi_region_access_point.test.details[0].name
+    policy = jsonencode({
+      "Version" : "2012-10-17",
+      "Statement" : [
+        {
+          "Sid" : "Test",
+          "Effect" : "Allow",
+          "Principal" : {
+            "AWS" : data.aws_caller_identity.current.account_id
+          },
+          "Action" : "s3:PutObject",
+          "Resource" : "arn:${data.aws_partition.current.partition}:s3::${data.aws_caller_identity.current.account_id}:accesspoint/${aws_s3control_multi_region_access_point.test.alias}/object/*"
+        }
+      ]
+    })
+  }
+}
+`)
+}
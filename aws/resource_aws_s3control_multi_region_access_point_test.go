@@ -0,0 +1,357 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSS3ControlMultiRegionAccessPoint_basic(t *testing.T) {
+	var v s3control.MultiRegionAccessPointReport
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	bucketName := acctest.RandomWithPrefix("tf-acc-test")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlMultiRegionAccessPointConfig(bucketName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlMultiRegionAccessPointExists(resourceName, &v),
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestMatchResourceAttr(resourceName, "alias", regexp.MustCompile(`^[a-z][a-z0-9]*[.]mrap$`)),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "s3", regexp.MustCompile(`accesspoint/[a-z][a-z0-9]*[.]mrap$`)),
+					resource.TestMatchResourceAttr(resourceName, "domain_name", regexp.MustCompile(`^[a-z][a-z0-9]*[.]mrap[.]accesspoint[.]s3-global[.]amazonaws[.]com$`)),
+					resource.TestCheckResourceAttr(resourceName, "details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.0.block_public_acls", "true"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.0.block_public_policy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.0.ignore_public_acls", "true"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.0.restrict_public_buckets", "true"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.region.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "details.0.region.*", map[string]string{
+						"bucket": bucketName,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.MultiRegionAccessPointStatusReady),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlMultiRegionAccessPoint_disappears(t *testing.T) {
+	var v s3control.MultiRegionAccessPointReport
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	bucketName := acctest.RandomWithPrefix("tf-acc-test")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlMultiRegionAccessPointConfig(bucketName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlMultiRegionAccessPointExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3ControlMultiRegionAccessPoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlMultiRegionAccessPoint_PublicAccessBlock(t *testing.T) {
+	var v s3control.MultiRegionAccessPointReport
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	bucketName := acctest.RandomWithPrefix("tf-acc-test")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlMultiRegionAccessPointConfigPublicAccessBlock(bucketName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlMultiRegionAccessPointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.0.block_public_acls", "false"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.0.block_public_policy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.0.ignore_public_acls", "false"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.0.restrict_public_buckets", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlMultiRegionAccessPoint_name(t *testing.T) {
+	var v1, v2 s3control.MultiRegionAccessPointReport
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	bucketName := acctest.RandomWithPrefix("tf-acc-test")
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlMultiRegionAccessPointConfig(bucketName, rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlMultiRegionAccessPointExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "details.0.name", rName1),
+				),
+			},
+			{
+				Config: testAccAWSS3ControlMultiRegionAccessPointConfig(bucketName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlMultiRegionAccessPointExists(resourceName, &v2),
+					testAccCheckAWSS3ControlMultiRegionAccessPointRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "details.0.name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlMultiRegionAccessPoint_threeRegions(t *testing.T) {
+	var providers []*schema.Provider
+	var v s3control.MultiRegionAccessPointReport
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	bucket1Name := acctest.RandomWithPrefix("tf-acc-test")
+	bucket2Name := acctest.RandomWithPrefix("tf-acc-test")
+	bucket3Name := acctest.RandomWithPrefix("tf-acc-test")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 3)
+		},
+		ErrorCheck:        testAccErrorCheck(t, s3control.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesMultipleRegion(&providers, 3),
+		CheckDestroy:      testAccCheckAWSS3ControlMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlMultiRegionAccessPointConfigThreeRegions(bucket1Name, bucket2Name, bucket3Name, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlMultiRegionAccessPointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "details.0.region.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "details.0.region.*", map[string]string{
+						"bucket": bucket1Name,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "details.0.region.*", map[string]string{
+						"bucket": bucket2Name,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "details.0.region.*", map[string]string{
+						"bucket": bucket3Name,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ControlMultiRegionAccessPointDestroy(s *terraform.State) error {
+	conn, err := s3ControlMultiRegionAccessPointConn(testAccProvider.Meta())
+
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_multi_region_access_point" {
+			continue
+		}
+
+		accountID, name, err := tfs3control.MultiRegionAccessPointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.MultiRegionAccessPointByAccountIDAndName(conn, accountID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Multi-Region Access Point %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3ControlMultiRegionAccessPointExists(n string, v *s3control.MultiRegionAccessPointReport) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Multi-Region Access Point ID is set")
+		}
+
+		accountID, name, err := tfs3control.MultiRegionAccessPointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn, err := s3ControlMultiRegionAccessPointConn(testAccProvider.Meta())
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.MultiRegionAccessPointByAccountIDAndName(conn, accountID, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3ControlMultiRegionAccessPointRecreated(before, after *s3control.MultiRegionAccessPointReport) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Alias), aws.StringValue(after.Alias); before == after {
+			return fmt.Errorf("S3 Multi-Region Access Point (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSS3ControlMultiRegionAccessPointConfig(bucketName, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  details {
+    name = %[2]q
+
+    region {
+      bucket = aws_s3_bucket.test.id
+    }
+  }
+}
+`, bucketName, rName)
+}
+
+func testAccAWSS3ControlMultiRegionAccessPointConfigPublicAccessBlock(bucketName, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  details {
+    name = %[2]q
+
+    public_access_block {
+      block_public_acls       = false
+      block_public_policy     = false
+      ignore_public_acls      = false
+      restrict_public_buckets = false
+    }
+
+    region {
+      bucket = aws_s3_bucket.test.id
+    }
+  }
+}
+`, bucketName, rName)
+}
+
+func testAccAWSS3ControlMultiRegionAccessPointConfigThreeRegions(bucket1Name, bucket2Name, bucket3Name, rName string) string {
+	return composeConfig(
+		testAccMultipleRegionProviderConfig(3),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test1" {
+  provider = aws
+
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket" "test2" {
+  provider = awsalternate
+
+  bucket        = %[2]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket" "test3" {
+  provider = awsthird
+
+  bucket        = %[3]q
+  force_destroy = true
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  provider = aws
+
+  details {
+    name = %[4]q
+
+    region {
+      bucket = aws_s3_bucket.test1.id
+    }
+
+    region {
+      bucket = aws_s3_bucket.test2.id
+    }
+
+    region {
+      bucket = aws_s3_bucket.test3.id
+    }
+  }
+}
+`, bucket1Name, bucket2Name, bucket3Name, rName))
+}
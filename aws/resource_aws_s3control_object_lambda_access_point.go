@@ -0,0 +1,439 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3ControlObjectLambdaAccessPoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ControlObjectLambdaAccessPointCreate,
+		Read:   resourceAwsS3ControlObjectLambdaAccessPointRead,
+		Update: resourceAwsS3ControlObjectLambdaAccessPointUpdate,
+		Delete: resourceAwsS3ControlObjectLambdaAccessPointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_features": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(s3control.ObjectLambdaAllowedFeature_Values(), false),
+							},
+						},
+						"cloud_watch_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"supporting_access_point": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"transformation_configuration": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"actions": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(s3control.ObjectLambdaTransformationConfigurationAction_Values(), false),
+										},
+									},
+									"content_transformation": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"aws_lambda": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"function_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+															"function_payload": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsS3ControlObjectLambdaAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	name := d.Get("name").(string)
+	resourceID := tfs3control.ObjectLambdaAccessPointCreateResourceID(accountID, name)
+
+	input := &s3control.CreateAccessPointForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandS3ControlObjectLambdaConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating S3 Object Lambda Access Point: %s", input)
+	_, err := conn.CreateAccessPointForObjectLambda(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Object Lambda Access Point (%s): %w", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return resourceAwsS3ControlObjectLambdaAccessPointRead(d, meta)
+}
+
+func resourceAwsS3ControlObjectLambdaAccessPointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID, name, err := tfs3control.ObjectLambdaAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	outputConfiguration, err := finder.ObjectLambdaAccessPointByAccountIDAndName(conn, accountID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Object Lambda Access Point (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Object Lambda Access Point (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	// https://docs.aws.amazon.com/service-authorization/latest/reference/list_amazons3objectlambda.html#amazons3objectlambda-resources-for-iam-policies.
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "s3-object-lambda",
+		Region:    meta.(*AWSClient).region,
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("accesspoint/%s", name),
+	}.String()
+	d.Set("arn", arn)
+	if err := d.Set("configuration", []interface{}{flattenS3ControlObjectLambdaConfiguration(outputConfiguration)}); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+	d.Set("name", name)
+
+	return nil
+}
+
+func resourceAwsS3ControlObjectLambdaAccessPointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID, name, err := tfs3control.ObjectLambdaAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3control.PutAccessPointConfigurationForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandS3ControlObjectLambdaConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating S3 Object Lambda Access Point: %s", input)
+	_, err = conn.PutAccessPointConfigurationForObjectLambda(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Object Lambda Access Point (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsS3ControlObjectLambdaAccessPointRead(d, meta)
+}
+
+func resourceAwsS3ControlObjectLambdaAccessPointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID, name, err := tfs3control.ObjectLambdaAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Object Lambda Access Point: %s", d.Id())
+	_, err = conn.DeleteAccessPointForObjectLambda(&s3control.DeleteAccessPointForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPoint) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Object Lambda Access Point (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3ControlObjectLambdaConfiguration(tfMap map[string]interface{}) *s3control.ObjectLambdaConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.ObjectLambdaConfiguration{}
+
+	if v, ok := tfMap["allowed_features"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedFeatures = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["cloud_watch_metrics_enabled"].(bool); ok && v {
+		apiObject.CloudWatchMetricsEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["supporting_access_point"].(string); ok && v != "" {
+		apiObject.SupportingAccessPoint = aws.String(v)
+	}
+
+	if v, ok := tfMap["transformation_configuration"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TransformationConfigurations = expandS3ControlObjectLambdaTransformationConfigurations(v.List())
+	}
+
+	return apiObject
+}
+
+func expandS3ControlObjectLambdaTransformationConfiguration(tfMap map[string]interface{}) *s3control.ObjectLambdaTransformationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.ObjectLambdaTransformationConfiguration{}
+
+	if v, ok := tfMap["actions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Actions = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["content_transformation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ContentTransformation = expandS3ControlObjectLambdaContentTransformation(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3ControlObjectLambdaTransformationConfigurations(tfList []interface{}) []*s3control.ObjectLambdaTransformationConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*s3control.ObjectLambdaTransformationConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandS3ControlObjectLambdaTransformationConfiguration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3ControlObjectLambdaContentTransformation(tfMap map[string]interface{}) *s3control.ObjectLambdaContentTransformation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.ObjectLambdaContentTransformation{}
+
+	if v, ok := tfMap["aws_lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AwsLambda = expandS3ControlAwsLambdaTransformation(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3ControlAwsLambdaTransformation(tfMap map[string]interface{}) *s3control.AwsLambdaTransformation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.AwsLambdaTransformation{}
+
+	if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+		apiObject.FunctionArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["function_payload"].(string); ok && v != "" {
+		apiObject.FunctionPayload = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3ControlObjectLambdaConfiguration(apiObject *s3control.ObjectLambdaConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AllowedFeatures; v != nil {
+		tfMap["allowed_features"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.CloudWatchMetricsEnabled; v != nil {
+		tfMap["cloud_watch_metrics_enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.SupportingAccessPoint; v != nil {
+		tfMap["supporting_access_point"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TransformationConfigurations; v != nil {
+		tfMap["transformation_configuration"] = flattenS3ControlObjectLambdaTransformationConfigurations(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3ControlObjectLambdaTransformationConfiguration(apiObject *s3control.ObjectLambdaTransformationConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Actions; v != nil {
+		tfMap["actions"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ContentTransformation; v != nil {
+		tfMap["content_transformation"] = []interface{}{flattenS3ControlObjectLambdaContentTransformation(v)}
+	}
+
+	return tfMap
+}
+
+func flattenS3ControlObjectLambdaTransformationConfigurations(apiObjects []*s3control.ObjectLambdaTransformationConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenS3ControlObjectLambdaTransformationConfiguration(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenS3ControlObjectLambdaContentTransformation(apiObject *s3control.ObjectLambdaContentTransformation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AwsLambda; v != nil {
+		tfMap["aws_lambda"] = []interface{}{flattenS3ControlAwsLambdaTransformation(v)}
+	}
+
+	return tfMap
+}
+
+func flattenS3ControlAwsLambdaTransformation(apiObject *s3control.AwsLambdaTransformation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FunctionArn; v != nil {
+		tfMap["function_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FunctionPayload; v != nil {
+		tfMap["function_payload"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3ControlObjectLambdaAccessPointPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ControlObjectLambdaAccessPointPolicyCreate,
+		Read:   resourceAwsS3ControlObjectLambdaAccessPointPolicyRead,
+		Update: resourceAwsS3ControlObjectLambdaAccessPointPolicyUpdate,
+		Delete: resourceAwsS3ControlObjectLambdaAccessPointPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"has_public_access_policy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsS3ControlObjectLambdaAccessPointPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	name := d.Get("name").(string)
+	resourceID := tfs3control.ObjectLambdaAccessPointCreateResourceID(accountID, name)
+
+	input := &s3control.PutAccessPointPolicyForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+		Policy:    aws.String(d.Get("policy").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating S3 Object Lambda Access Point Policy: %s", input)
+	_, err := conn.PutAccessPointPolicyForObjectLambda(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Object Lambda Access Point (%s) Policy: %w", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return resourceAwsS3ControlObjectLambdaAccessPointPolicyRead(d, meta)
+}
+
+func resourceAwsS3ControlObjectLambdaAccessPointPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID, name, err := tfs3control.ObjectLambdaAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	policy, status, err := finder.ObjectLambdaAccessPointPolicyAndStatusByAccountIDAndName(conn, accountID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Object Lambda Access Point Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Object Lambda Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("has_public_access_policy", status.IsPublic)
+	d.Set("name", name)
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceAwsS3ControlObjectLambdaAccessPointPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID, name, err := tfs3control.ObjectLambdaAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3control.PutAccessPointPolicyForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+		Policy:    aws.String(d.Get("policy").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating S3 Object Lambda Access Point Policy: %s", input)
+	_, err = conn.PutAccessPointPolicyForObjectLambda(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Object Lambda Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsS3ControlObjectLambdaAccessPointPolicyRead(d, meta)
+}
+
+func resourceAwsS3ControlObjectLambdaAccessPointPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID, name, err := tfs3control.ObjectLambdaAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Object Lambda Access Point Policy: %s", d.Id())
+	_, err = conn.DeleteAccessPointPolicyForObjectLambda(&s3control.DeleteAccessPointPolicyForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPoint) || tfawserr.ErrCodeEquals(err, tfs3control.ErrCodeNoSuchAccessPointPolicy) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Object Lambda Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
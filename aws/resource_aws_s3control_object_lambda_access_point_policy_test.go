@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSS3ControlObjectLambdaAccessPointPolicy_basic(t *testing.T) {
+	resourceName := "aws_s3control_object_lambda_access_point_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlObjectLambdaAccessPointPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlObjectLambdaAccessPointPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlObjectLambdaAccessPointPolicyExists(resourceName),
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "has_public_access_policy", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", "aws_s3control_object_lambda_access_point.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlObjectLambdaAccessPointPolicy_disappears(t *testing.T) {
+	resourceName := "aws_s3control_object_lambda_access_point_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlObjectLambdaAccessPointPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlObjectLambdaAccessPointPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlObjectLambdaAccessPointPolicyExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3ControlObjectLambdaAccessPointPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlObjectLambdaAccessPointPolicy_disappears_AccessPoint(t *testing.T) {
+	resourceName := "aws_s3control_object_lambda_access_point_policy.test"
+	accessPointResourceName := "aws_s3control_object_lambda_access_point.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlObjectLambdaAccessPointPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlObjectLambdaAccessPointPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlObjectLambdaAccessPointPolicyExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3ControlObjectLambdaAccessPoint(), accessPointResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlObjectLambdaAccessPointPolicy_update(t *testing.T) {
+	resourceName := "aws_s3control_object_lambda_access_point_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlObjectLambdaAccessPointPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlObjectLambdaAccessPointPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlObjectLambdaAccessPointPolicyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3ControlObjectLambdaAccessPointPolicyConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlObjectLambdaAccessPointPolicyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ControlObjectLambdaAccessPointPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_object_lambda_access_point_policy" {
+			continue
+		}
+
+		accountID, name, err := tfs3control.ObjectLambdaAccessPointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, _, err = finder.ObjectLambdaAccessPointPolicyAndStatusByAccountIDAndName(conn, accountID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object Lambda Access Point Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3ControlObjectLambdaAccessPointPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Object Lambda Access Point Policy ID is set")
+		}
+
+		accountID, name, err := tfs3control.ObjectLambdaAccessPointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+		_, _, err = finder.ObjectLambdaAccessPointPolicyAndStatusByAccountIDAndName(conn, accountID, name)
+
+		return err
+	}
+}
+
+func testAccAWSS3ControlObjectLambdaAccessPointPolicyConfig(rName string) string {
+	return composeConfig(testAccAWSS3ControlObjectLambdaAccessPointConfig(rName), `
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3control_object_lambda_access_point_policy" "test" {
+  name = aws_s3control_object_lambda_access_point.test.name
+
+  policy = jsonencode({
+    Version = "2008-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "s3-object-lambda:GetObject"
+      Principal = {
+        AWS = data.aws_caller_identity.current.account_id
+      }
+      Resource = aws_s3control_object_lambda_access_point.test.arn
+    }]
+  })
+}
+`)
+}
+
+func testAccAWSS3ControlObjectLambdaAccessPointPolicyConfigUpdated(rName string) string {
+	return composeConfig(testAccAWSS3ControlObjectLambdaAccessPointConfig(rName), `
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3control_object_lambda_access_point_policy" "test" {
+  name = aws_s3control_object_lambda_access_point.test.name
+
+  policy = jsonencode({
+    Version = "2008-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "s3-object-lambda:*"
+      Principal = {
+        AWS = data.aws_caller_identity.current.account_id
+      }
+      Resource = aws_s3control_object_lambda_access_point.test.arn
+    }]
+  })
+}
+`)
+}
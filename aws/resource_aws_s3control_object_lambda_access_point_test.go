@@ -0,0 +1,282 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSS3ControlObjectLambdaAccessPoint_basic(t *testing.T) {
+	var v s3control.ObjectLambdaConfiguration
+	resourceName := "aws_s3control_object_lambda_access_point.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	accessPointResourceName := "aws_s3_access_point.test"
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlObjectLambdaAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlObjectLambdaAccessPointConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlObjectLambdaAccessPointExists(resourceName, &v),
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "s3-object-lambda", fmt.Sprintf("accesspoint/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.allowed_features.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.cloud_watch_metrics_enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.supporting_access_point", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.transformation_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.0.transformation_configuration.*", map[string]string{
+						"actions.#":                                              "1",
+						"content_transformation.#":                               "1",
+						"content_transformation.0.aws_lambda.#":                  "1",
+						"content_transformation.0.aws_lambda.0.function_payload": "",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.transformation_configuration.*.actions.*", "GetObject"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "configuration.0.transformation_configuration.*.content_transformation.0.aws_lambda.0.function_arn", lambdaFunctionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlObjectLambdaAccessPoint_disappears(t *testing.T) {
+	var v s3control.ObjectLambdaConfiguration
+	resourceName := "aws_s3control_object_lambda_access_point.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlObjectLambdaAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlObjectLambdaAccessPointConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlObjectLambdaAccessPointExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3ControlObjectLambdaAccessPoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlObjectLambdaAccessPoint_update(t *testing.T) {
+	var v s3control.ObjectLambdaConfiguration
+	resourceName := "aws_s3control_object_lambda_access_point.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	accessPointResourceName := "aws_s3_access_point.test"
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3control.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlObjectLambdaAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlObjectLambdaAccessPointConfigOptionals(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlObjectLambdaAccessPointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.allowed_features.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.allowed_features.*", "GetObject-PartNumber"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.allowed_features.*", "GetObject-Range"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.cloud_watch_metrics_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.supporting_access_point", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.transformation_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.0.transformation_configuration.*", map[string]string{
+						"actions.#":                                              "1",
+						"content_transformation.#":                               "1",
+						"content_transformation.0.aws_lambda.#":                  "1",
+						"content_transformation.0.aws_lambda.0.function_payload": "{\"res-x\": \"100\",\"res-y\": \"100\"}",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "configuration.0.transformation_configuration.*.content_transformation.0.aws_lambda.0.function_arn", lambdaFunctionResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3ControlObjectLambdaAccessPointConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlObjectLambdaAccessPointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.allowed_features.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.cloud_watch_metrics_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.transformation_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.0.transformation_configuration.*", map[string]string{
+						"content_transformation.0.aws_lambda.0.function_payload": "",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ControlObjectLambdaAccessPointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_object_lambda_access_point" {
+			continue
+		}
+
+		accountID, name, err := tfs3control.ObjectLambdaAccessPointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ObjectLambdaAccessPointByAccountIDAndName(conn, accountID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object Lambda Access Point %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3ControlObjectLambdaAccessPointExists(n string, v *s3control.ObjectLambdaConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Object Lambda Access Point ID is set")
+		}
+
+		accountID, name, err := tfs3control.ObjectLambdaAccessPointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+		output, err := finder.ObjectLambdaAccessPointByAccountIDAndName(conn, accountID, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSS3ControlObjectLambdaAccessPointBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSS3ControlObjectLambdaAccessPointConfig(rName string) string {
+	return composeConfig(testAccAWSS3ControlObjectLambdaAccessPointBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3control_object_lambda_access_point" "test" {
+  name = %[1]q
+
+  configuration {
+    supporting_access_point = aws_s3_access_point.test.arn
+
+    transformation_configuration {
+      actions = ["GetObject"]
+
+      content_transformation {
+        aws_lambda {
+          function_arn = aws_lambda_function.test.arn
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSS3ControlObjectLambdaAccessPointConfigOptionals(rName string) string {
+	return composeConfig(testAccAWSS3ControlObjectLambdaAccessPointBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3control_object_lambda_access_point" "test" {
+  name = %[1]q
+
+  configuration {
+    allowed_features            = ["GetObject-Range", "GetObject-PartNumber"]
+    cloud_watch_metrics_enabled = true
+    supporting_access_point     = aws_s3_access_point.test.arn
+
+    transformation_configuration {
+      actions = ["GetObject"]
+
+      content_transformation {
+        aws_lambda {
+          function_arn     = aws_lambda_function.test.arn
+          function_payload = "{\"res-x\": \"100\",\"res-y\": \"100\"}"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
@@ -311,6 +311,11 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"tags_all": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 				Set:          hashLaunchSpecification,
@@ -626,10 +631,10 @@ func buildSpotFleetLaunchSpecification(d map[string]interface{}, meta interface{
 		}
 	}
 
-	if m, ok := d["tags"].(map[string]interface{}); ok && len(m) > 0 {
-		tagsSpec := make([]*ec2.SpotFleetTagSpecification, 0)
+	m, _ := d["tags"].(map[string]interface{})
 
-		tags := keyvaluetags.New(m).IgnoreAws().Ec2Tags()
+	if tags := ec2SubResourceTags(m, meta.(*AWSClient).DefaultTagsConfig, meta.(*AWSClient).IgnoreTagsConfig).Ec2Tags(); len(tags) > 0 {
+		tagsSpec := make([]*ec2.SpotFleetTagSpecification, 0)
 
 		spec := &ec2.SpotFleetTagSpecification{
 			ResourceType: aws.String(ec2.ResourceTypeInstance),
@@ -1292,7 +1297,7 @@ func resourceAwsSpotFleetRequestRead(d *schema.ResourceData, meta interface{}) e
 			aws.TimeValue(config.ValidUntil).Format(time.RFC3339))
 	}
 
-	launchSpec, err := launchSpecsToSet(config.LaunchSpecifications, conn)
+	launchSpec, err := launchSpecsToSet(config.LaunchSpecifications, conn, defaultTagsConfig, ignoreTagsConfig)
 	if err != nil {
 		return fmt.Errorf("error occurred while reading launch specification: %w", err)
 	}
@@ -1378,7 +1383,7 @@ func flattenSpotFleetRequestLaunchTemplateOverrides(override *ec2.LaunchTemplate
 	return m
 }
 
-func launchSpecsToSet(launchSpecs []*ec2.SpotFleetLaunchSpecification, conn *ec2.EC2) (*schema.Set, error) {
+func launchSpecsToSet(launchSpecs []*ec2.SpotFleetLaunchSpecification, conn *ec2.EC2, defaultTagsConfig *keyvaluetags.DefaultConfig, ignoreTagsConfig *keyvaluetags.IgnoreConfig) (*schema.Set, error) {
 	specSet := &schema.Set{F: hashLaunchSpecification}
 	for _, spec := range launchSpecs {
 		rootDeviceName, err := fetchRootDeviceName(aws.StringValue(spec.ImageId), conn)
@@ -1386,12 +1391,12 @@ func launchSpecsToSet(launchSpecs []*ec2.SpotFleetLaunchSpecification, conn *ec2
 			return nil, err
 		}
 
-		specSet.Add(launchSpecToMap(spec, rootDeviceName))
+		specSet.Add(launchSpecToMap(spec, rootDeviceName, defaultTagsConfig, ignoreTagsConfig))
 	}
 	return specSet, nil
 }
 
-func launchSpecToMap(l *ec2.SpotFleetLaunchSpecification, rootDevName *string) map[string]interface{} {
+func launchSpecToMap(l *ec2.SpotFleetLaunchSpecification, rootDevName *string, defaultTagsConfig *keyvaluetags.DefaultConfig, ignoreTagsConfig *keyvaluetags.IgnoreConfig) map[string]interface{} {
 	m := make(map[string]interface{})
 
 	m["root_block_device"] = rootBlockDeviceToSet(l.BlockDeviceMappings, rootDevName)
@@ -1465,7 +1470,10 @@ func launchSpecToMap(l *ec2.SpotFleetLaunchSpecification, rootDevName *string) m
 		for _, tagSpecs := range l.TagSpecifications {
 			// only "instance" tags are currently supported: http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_SpotFleetTagSpecification.html
 			if aws.StringValue(tagSpecs.ResourceType) == ec2.ResourceTypeInstance {
-				m["tags"] = keyvaluetags.Ec2KeyValueTags(tagSpecs.Tags).IgnoreAws().Map()
+				tags := keyvaluetags.Ec2KeyValueTags(tagSpecs.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+				m["tags"] = tags.RemoveDefaultConfig(defaultTagsConfig).Map()
+				m["tags_all"] = tags.Map()
 			}
 		}
 	}
@@ -50,6 +50,18 @@ func resourceAwsSpotInstanceRequest() *schema.Resource {
 				v.ForceNew = true
 			}
 
+			delete(s, "volume_tags_all")
+
+			// Spot instance requests cannot be launched from a launch template.
+			delete(s, "launch_template")
+
+			for _, k := range []string{"ami", "instance_type"} {
+				s[k].AtLeastOneOf = nil
+				s[k].Computed = false
+				s[k].Optional = false
+				s[k].Required = true
+			}
+
 			s["volume_tags"] = &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -424,7 +436,7 @@ func readInstance(d *schema.ResourceData, meta interface{}) error {
 			"host": *instance.PrivateIpAddress,
 		})
 	}
-	if err := readBlockDevices(d, instance, conn); err != nil {
+	if err := readInstanceBlockDevices(d, instance, conn, meta.(*AWSClient).DefaultTagsConfig, meta.(*AWSClient).IgnoreTagsConfig); err != nil {
 		return err
 	}
 
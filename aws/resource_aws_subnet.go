@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,8 +9,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
@@ -27,7 +30,10 @@ func resourceAwsSubnet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			subnetIpv6NativeDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -46,7 +52,7 @@ func resourceAwsSubnet() *schema.Resource {
 
 			"cidr_block": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validateIpv4CIDRNetworkAddress,
 			},
@@ -109,6 +115,38 @@ func resourceAwsSubnet() *schema.Resource {
 				Computed: true,
 			},
 
+			"ipv6_native": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"enable_dns64": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_resource_name_dns_aaaa_record_on_launch": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_resource_name_dns_a_record_on_launch": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"private_dns_hostname_type_on_launch": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ec2.HostnameType_Values(), false),
+			},
+
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -126,6 +164,32 @@ func resourceAwsSubnet() *schema.Resource {
 	}
 }
 
+// subnetIpv6NativeDiff returns an error if an IPv6-only subnet has no IPv6 CIDR block or has an IPv4 CIDR block,
+// or if any other subnet has no IPv4 CIDR block.
+func subnetIpv6NativeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("ipv6_native") {
+		return nil
+	}
+
+	if diff.Get("ipv6_native").(bool) {
+		if diff.NewValueKnown("ipv6_cidr_block") && diff.Get("ipv6_cidr_block").(string) == "" {
+			return fmt.Errorf("ipv6_cidr_block must be set when ipv6_native is true")
+		}
+
+		if diff.NewValueKnown("cidr_block") && diff.Get("cidr_block").(string) != "" {
+			return fmt.Errorf("cidr_block cannot be set when ipv6_native is true")
+		}
+
+		return nil
+	}
+
+	if diff.NewValueKnown("cidr_block") && diff.Get("cidr_block").(string) == "" {
+		return fmt.Errorf("cidr_block must be set unless ipv6_native is true")
+	}
+
+	return nil
+}
+
 func resourceAwsSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
@@ -134,15 +198,22 @@ func resourceAwsSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	createOpts := &ec2.CreateSubnetInput{
 		AvailabilityZone:   aws.String(d.Get("availability_zone").(string)),
 		AvailabilityZoneId: aws.String(d.Get("availability_zone_id").(string)),
-		CidrBlock:          aws.String(d.Get("cidr_block").(string)),
 		VpcId:              aws.String(d.Get("vpc_id").(string)),
 		TagSpecifications:  ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSubnet),
 	}
 
+	if v, ok := d.GetOk("cidr_block"); ok {
+		createOpts.CidrBlock = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("ipv6_cidr_block"); ok {
 		createOpts.Ipv6CidrBlock = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("ipv6_native"); ok {
+		createOpts.Ipv6Native = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("outpost_arn"); ok {
 		createOpts.OutpostArn = aws.String(v.(string))
 	}
@@ -227,6 +298,30 @@ func resourceAwsSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.Get("enable_dns64").(bool) {
+		if err := resourceAwsSubnetModifyEnableDns64(conn, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("enable_resource_name_dns_aaaa_record_on_launch").(bool) {
+		if err := resourceAwsSubnetModifyEnableResourceNameDnsAAAARecordOnLaunch(conn, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("enable_resource_name_dns_a_record_on_launch").(bool) {
+		if err := resourceAwsSubnetModifyEnableResourceNameDnsARecordOnLaunch(conn, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("private_dns_hostname_type_on_launch"); ok {
+		if err := resourceAwsSubnetModifyPrivateDnsHostnameTypeOnLaunch(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsSubnetRead(d, meta)
 }
 
@@ -291,8 +386,20 @@ func resourceAwsSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("map_customer_owned_ip_on_launch", subnet.MapCustomerOwnedIpOnLaunch)
 	d.Set("map_public_ip_on_launch", subnet.MapPublicIpOnLaunch)
 	d.Set("assign_ipv6_address_on_creation", subnet.AssignIpv6AddressOnCreation)
+	d.Set("enable_dns64", subnet.EnableDns64)
+	d.Set("ipv6_native", subnet.Ipv6Native)
 	d.Set("outpost_arn", subnet.OutpostArn)
 
+	if subnet.PrivateDnsNameOptionsOnLaunch != nil {
+		d.Set("enable_resource_name_dns_aaaa_record_on_launch", subnet.PrivateDnsNameOptionsOnLaunch.EnableResourceNameDnsAAAARecord)
+		d.Set("enable_resource_name_dns_a_record_on_launch", subnet.PrivateDnsNameOptionsOnLaunch.EnableResourceNameDnsARecord)
+		d.Set("private_dns_hostname_type_on_launch", subnet.PrivateDnsNameOptionsOnLaunch.HostnameType)
+	} else {
+		d.Set("enable_resource_name_dns_aaaa_record_on_launch", nil)
+		d.Set("enable_resource_name_dns_a_record_on_launch", nil)
+		d.Set("private_dns_hostname_type_on_launch", nil)
+	}
+
 	// Make sure those values are set, if an IPv6 block exists it'll be set in the loop
 	d.Set("ipv6_cidr_block_association_id", "")
 	d.Set("ipv6_cidr_block", "")
@@ -478,6 +585,30 @@ func resourceAwsSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("enable_dns64") {
+		if err := resourceAwsSubnetModifyEnableDns64(conn, d.Id(), d.Get("enable_dns64").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("enable_resource_name_dns_aaaa_record_on_launch") {
+		if err := resourceAwsSubnetModifyEnableResourceNameDnsAAAARecordOnLaunch(conn, d.Id(), d.Get("enable_resource_name_dns_aaaa_record_on_launch").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("enable_resource_name_dns_a_record_on_launch") {
+		if err := resourceAwsSubnetModifyEnableResourceNameDnsARecordOnLaunch(conn, d.Id(), d.Get("enable_resource_name_dns_a_record_on_launch").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("private_dns_hostname_type_on_launch") {
+		if err := resourceAwsSubnetModifyPrivateDnsHostnameTypeOnLaunch(conn, d.Id(), d.Get("private_dns_hostname_type_on_launch").(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsSubnetRead(d, meta)
 }
 
@@ -525,6 +656,80 @@ func resourceAwsSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceAwsSubnetModifyEnableDns64(conn *ec2.EC2, subnetID string, v bool) error {
+	input := &ec2.ModifySubnetAttributeInput{
+		EnableDns64: &ec2.AttributeBooleanValue{
+			Value: aws.Bool(v),
+		},
+		SubnetId: aws.String(subnetID),
+	}
+
+	if _, err := conn.ModifySubnetAttribute(input); err != nil {
+		return fmt.Errorf("error setting EC2 Subnet (%s) enable DNS64: %w", subnetID, err)
+	}
+
+	if _, err := waiter.SubnetEnableDns64Updated(conn, subnetID, v); err != nil {
+		return fmt.Errorf("error waiting for EC2 Subnet (%s) enable DNS64 update: %w", subnetID, err)
+	}
+
+	return nil
+}
+
+func resourceAwsSubnetModifyEnableResourceNameDnsAAAARecordOnLaunch(conn *ec2.EC2, subnetID string, v bool) error {
+	input := &ec2.ModifySubnetAttributeInput{
+		EnableResourceNameDnsAAAARecordOnLaunch: &ec2.AttributeBooleanValue{
+			Value: aws.Bool(v),
+		},
+		SubnetId: aws.String(subnetID),
+	}
+
+	if _, err := conn.ModifySubnetAttribute(input); err != nil {
+		return fmt.Errorf("error setting EC2 Subnet (%s) enable resource name DNS AAAA record on launch: %w", subnetID, err)
+	}
+
+	if _, err := waiter.SubnetEnableResourceNameDnsAAAARecordOnLaunchUpdated(conn, subnetID, v); err != nil {
+		return fmt.Errorf("error waiting for EC2 Subnet (%s) enable resource name DNS AAAA record on launch update: %w", subnetID, err)
+	}
+
+	return nil
+}
+
+func resourceAwsSubnetModifyEnableResourceNameDnsARecordOnLaunch(conn *ec2.EC2, subnetID string, v bool) error {
+	input := &ec2.ModifySubnetAttributeInput{
+		EnableResourceNameDnsARecordOnLaunch: &ec2.AttributeBooleanValue{
+			Value: aws.Bool(v),
+		},
+		SubnetId: aws.String(subnetID),
+	}
+
+	if _, err := conn.ModifySubnetAttribute(input); err != nil {
+		return fmt.Errorf("error setting EC2 Subnet (%s) enable resource name DNS A record on launch: %w", subnetID, err)
+	}
+
+	if _, err := waiter.SubnetEnableResourceNameDnsARecordOnLaunchUpdated(conn, subnetID, v); err != nil {
+		return fmt.Errorf("error waiting for EC2 Subnet (%s) enable resource name DNS A record on launch update: %w", subnetID, err)
+	}
+
+	return nil
+}
+
+func resourceAwsSubnetModifyPrivateDnsHostnameTypeOnLaunch(conn *ec2.EC2, subnetID string, v string) error {
+	input := &ec2.ModifySubnetAttributeInput{
+		PrivateDnsHostnameTypeOnLaunch: aws.String(v),
+		SubnetId:                       aws.String(subnetID),
+	}
+
+	if _, err := conn.ModifySubnetAttribute(input); err != nil {
+		return fmt.Errorf("error setting EC2 Subnet (%s) private DNS hostname type on launch: %w", subnetID, err)
+	}
+
+	if _, err := waiter.SubnetPrivateDnsHostnameTypeOnLaunchUpdated(conn, subnetID, v); err != nil {
+		return fmt.Errorf("error waiting for EC2 Subnet (%s) private DNS hostname type on launch update: %w", subnetID, err)
+	}
+
+	return nil
+}
+
 // SubnetStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch a Subnet.
 func SubnetStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -42,10 +42,29 @@ func resourceAwsVpc() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsCIDRNetwork(16, 28),
+				ConflictsWith: []string{"ipv4_netmask_length"},
+				AtLeastOneOf:  []string{"cidr_block", "ipv4_ipam_pool_id"},
+			},
+
+			"ipv4_ipam_pool_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.IsCIDRNetwork(16, 28),
+				AtLeastOneOf: []string{"cidr_block", "ipv4_ipam_pool_id"},
+			},
+
+			"ipv4_netmask_length": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(16, 28),
+				ConflictsWith: []string{"cidr_block"},
+				RequiredWith:  []string{"ipv4_ipam_pool_id"},
 			},
 
 			"instance_tenancy": {
@@ -144,12 +163,23 @@ func resourceAwsVpcCreate(d *schema.ResourceData, meta interface{}) error {
 
 	// Create the VPC
 	createOpts := &ec2.CreateVpcInput{
-		CidrBlock:                   aws.String(d.Get("cidr_block").(string)),
 		InstanceTenancy:             aws.String(d.Get("instance_tenancy").(string)),
 		AmazonProvidedIpv6CidrBlock: aws.Bool(d.Get("assign_generated_ipv6_cidr_block").(bool)),
 		TagSpecifications:           ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeVpc),
 	}
 
+	if v, ok := d.GetOk("cidr_block"); ok {
+		createOpts.CidrBlock = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ipv4_ipam_pool_id"); ok {
+		createOpts.Ipv4IpamPoolId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ipv4_netmask_length"); ok {
+		createOpts.Ipv4NetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
 	log.Printf("[DEBUG] VPC create config: %#v", *createOpts)
 	vpcResp, err := conn.CreateVpc(createOpts)
 	if err != nil {
@@ -0,0 +1,297 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsVpcIpam() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpamCreate,
+		Read:   resourceAwsVpcIpamRead,
+		Update: resourceAwsVpcIpamUpdate,
+		Delete: resourceAwsVpcIpamDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			resourceAwsVpcIpamCustomizeDiff,
+			SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"operating_regions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"private_default_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_default_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scope_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsVpcIpamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateIpamInput{
+		OperatingRegions:  expandEc2AddIpamOperatingRegions(d.Get("operating_regions").(*schema.Set).List()),
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeIpam),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC IPAM: %s", input)
+	output, err := conn.CreateIpam(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 VPC IPAM: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Ipam.IpamId))
+
+	if _, err := waiter.IpamCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpamRead(d, meta)
+}
+
+func resourceAwsVpcIpamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	ipam, err := finder.IpamByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC IPAM (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC IPAM (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", ipam.IpamArn)
+	d.Set("description", ipam.Description)
+	if err := d.Set("operating_regions", flattenEc2IpamOperatingRegions(ipam.OperatingRegions)); err != nil {
+		return fmt.Errorf("error setting operating_regions: %w", err)
+	}
+	d.Set("private_default_scope_id", ipam.PrivateDefaultScopeId)
+	d.Set("public_default_scope_id", ipam.PublicDefaultScopeId)
+	d.Set("scope_count", ipam.ScopeCount)
+
+	tags := keyvaluetags.Ec2KeyValueTags(ipam.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsVpcIpamUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChanges("description", "operating_regions") {
+		input := &ec2.ModifyIpamInput{
+			IpamId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("operating_regions") {
+			o, n := d.GetChange("operating_regions")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if v := ns.Difference(os).List(); len(v) > 0 {
+				input.AddOperatingRegions = expandEc2AddIpamOperatingRegions(v)
+			}
+
+			if v := os.Difference(ns).List(); len(v) > 0 {
+				input.RemoveOperatingRegions = expandEc2RemoveIpamOperatingRegions(v)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating EC2 VPC IPAM: %s", input)
+		if _, err := conn.ModifyIpam(input); err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.IpamUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 VPC IPAM (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsVpcIpamRead(d, meta)
+}
+
+func resourceAwsVpcIpamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[INFO] Deleting EC2 VPC IPAM: %s", d.Id())
+	_, err := conn.DeleteIpam(&ec2.DeleteIpamInput{
+		IpamId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 VPC IPAM (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.IpamDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsVpcIpamCustomizeDiff ensures that the IPAM's home Region, i.e. the provider's Region,
+// is one of its operating Regions.
+func resourceAwsVpcIpamCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("operating_regions") {
+		return nil
+	}
+
+	currentRegion := meta.(*AWSClient).region
+
+	for _, tfMapRaw := range diff.Get("operating_regions").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["region_name"].(string); ok && v == currentRegion {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("`operating_regions` must include the current region (%s)", currentRegion)
+}
+
+func expandEc2AddIpamOperatingRegions(tfList []interface{}) []*ec2.AddIpamOperatingRegion {
+	var apiObjects []*ec2.AddIpamOperatingRegion
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ec2.AddIpamOperatingRegion{
+			RegionName: aws.String(tfMap["region_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandEc2RemoveIpamOperatingRegions(tfList []interface{}) []*ec2.RemoveIpamOperatingRegion {
+	var apiObjects []*ec2.RemoveIpamOperatingRegion
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ec2.RemoveIpamOperatingRegion{
+			RegionName: aws.String(tfMap["region_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenEc2IpamOperatingRegions(apiObjects []*ec2.IpamOperatingRegion) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"region_name": aws.StringValue(apiObject.RegionName),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,372 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsVpcIpamPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpamPoolCreate,
+		Read:   resourceAwsVpcIpamPoolRead,
+		Update: resourceAwsVpcIpamPoolUpdate,
+		Delete: resourceAwsVpcIpamPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.AddressFamily_Values(), false),
+			},
+			"allocation_default_netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"allocation_max_netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"allocation_min_netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"allocation_resource_tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_import": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"aws_service": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.IpamPoolAwsService_Values(), false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"ipam_scope_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ipam_scope_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"pool_depth": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"publicly_advertisable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_ipam_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsVpcIpamPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateIpamPoolInput{
+		AddressFamily:     aws.String(d.Get("address_family").(string)),
+		IpamScopeId:       aws.String(d.Get("ipam_scope_id").(string)),
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeIpamPool),
+	}
+
+	if v, ok := d.GetOk("allocation_default_netmask_length"); ok {
+		input.AllocationDefaultNetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("allocation_max_netmask_length"); ok {
+		input.AllocationMaxNetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("allocation_min_netmask_length"); ok {
+		input.AllocationMinNetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("allocation_resource_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AllocationResourceTags = expandEc2RequestIpamResourceTags(keyvaluetags.New(v.(map[string]interface{})))
+	}
+
+	if v, ok := d.GetOk("auto_import"); ok {
+		input.AutoImport = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("aws_service"); ok {
+		input.AwsService = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("publicly_advertisable"); ok {
+		input.PubliclyAdvertisable = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("source_ipam_pool_id"); ok {
+		input.SourceIpamPoolId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC IPAM Pool: %s", input)
+	output, err := conn.CreateIpamPool(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 VPC IPAM Pool: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.IpamPool.IpamPoolId))
+
+	if _, err := waiter.IpamPoolCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Pool (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpamPoolRead(d, meta)
+}
+
+func resourceAwsVpcIpamPoolRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	pool, err := finder.IpamPoolByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC IPAM Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC IPAM Pool (%s): %w", d.Id(), err)
+	}
+
+	scopeID, err := ec2IpamResourceIDFromArn(ec2.ResourceTypeIpamScope, aws.StringValue(pool.IpamScopeArn))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("address_family", pool.AddressFamily)
+	d.Set("allocation_default_netmask_length", pool.AllocationDefaultNetmaskLength)
+	d.Set("allocation_max_netmask_length", pool.AllocationMaxNetmaskLength)
+	d.Set("allocation_min_netmask_length", pool.AllocationMinNetmaskLength)
+	if err := d.Set("allocation_resource_tags", flattenEc2IpamResourceTags(pool.AllocationResourceTags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting allocation_resource_tags: %w", err)
+	}
+	d.Set("arn", pool.IpamPoolArn)
+	d.Set("auto_import", pool.AutoImport)
+	d.Set("aws_service", pool.AwsService)
+	d.Set("description", pool.Description)
+	d.Set("ipam_scope_id", scopeID)
+	d.Set("ipam_scope_type", pool.IpamScopeType)
+	// Pools without a locale report it as "None".
+	if v := aws.StringValue(pool.Locale); v != tfec2.IpamPoolLocaleNone {
+		d.Set("locale", v)
+	} else {
+		d.Set("locale", "")
+	}
+	d.Set("pool_depth", pool.PoolDepth)
+	d.Set("publicly_advertisable", pool.PubliclyAdvertisable)
+	d.Set("source_ipam_pool_id", pool.SourceIpamPoolId)
+	d.Set("state", pool.State)
+
+	tags := keyvaluetags.Ec2KeyValueTags(pool.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsVpcIpamPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifyIpamPoolInput{
+			IpamPoolId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("allocation_default_netmask_length") {
+			if v, ok := d.GetOk("allocation_default_netmask_length"); ok {
+				input.AllocationDefaultNetmaskLength = aws.Int64(int64(v.(int)))
+			} else {
+				input.ClearAllocationDefaultNetmaskLength = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("allocation_max_netmask_length") {
+			input.AllocationMaxNetmaskLength = aws.Int64(int64(d.Get("allocation_max_netmask_length").(int)))
+		}
+
+		if d.HasChange("allocation_min_netmask_length") {
+			input.AllocationMinNetmaskLength = aws.Int64(int64(d.Get("allocation_min_netmask_length").(int)))
+		}
+
+		if d.HasChange("allocation_resource_tags") {
+			o, n := d.GetChange("allocation_resource_tags")
+			oldTags := keyvaluetags.New(o)
+			newTags := keyvaluetags.New(n)
+
+			removedTags := oldTags.Removed(newTags)
+			updatedTags := oldTags.Updated(newTags)
+
+			// Allocation resource tags are key/value pairs, so the old pair must be removed when a value changes.
+			for k := range updatedTags {
+				if v, ok := oldTags[k]; ok {
+					removedTags[k] = v
+				}
+			}
+
+			if len(removedTags) > 0 {
+				input.RemoveAllocationResourceTags = expandEc2RequestIpamResourceTags(removedTags)
+			}
+
+			if len(updatedTags) > 0 {
+				input.AddAllocationResourceTags = expandEc2RequestIpamResourceTags(updatedTags)
+			}
+		}
+
+		if d.HasChange("auto_import") {
+			input.AutoImport = aws.Bool(d.Get("auto_import").(bool))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		log.Printf("[DEBUG] Updating EC2 VPC IPAM Pool: %s", input)
+		if _, err := conn.ModifyIpamPool(input); err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM Pool (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.IpamPoolUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 VPC IPAM Pool (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM Pool (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsVpcIpamPoolRead(d, meta)
+}
+
+func resourceAwsVpcIpamPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[INFO] Deleting EC2 VPC IPAM Pool: %s", d.Id())
+	_, err := conn.DeleteIpamPool(&ec2.DeleteIpamPoolInput{
+		IpamPoolId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamPoolIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 VPC IPAM Pool (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.IpamPoolDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Pool (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEc2RequestIpamResourceTags(tags keyvaluetags.KeyValueTags) []*ec2.RequestIpamResourceTag {
+	var apiObjects []*ec2.RequestIpamResourceTag
+
+	for k, v := range tags.Map() {
+		apiObjects = append(apiObjects, &ec2.RequestIpamResourceTag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenEc2IpamResourceTags(apiObjects []*ec2.IpamResourceTag) keyvaluetags.KeyValueTags {
+	m := make(map[string]*string, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		m[aws.StringValue(apiObject.Key)] = apiObject.Value
+	}
+
+	return keyvaluetags.New(m)
+}
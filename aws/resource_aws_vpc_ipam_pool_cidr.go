@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsVpcIpamPoolCidr() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpamPoolCidrCreate,
+		Read:   resourceAwsVpcIpamPoolCidrRead,
+		Delete: resourceAwsVpcIpamPoolCidrDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"cidr_authorization_context": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"signature": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcIpamPoolCidrCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	poolID := d.Get("ipam_pool_id").(string)
+	input := &ec2.ProvisionIpamPoolCidrInput{
+		Cidr:       aws.String(d.Get("cidr").(string)),
+		IpamPoolId: aws.String(poolID),
+	}
+
+	if v, ok := d.GetOk("cidr_authorization_context"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CidrAuthorizationContext = expandEc2IpamCidrAuthorizationContext(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC IPAM Pool CIDR: %s", input)
+	output, err := conn.ProvisionIpamPoolCidr(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 VPC IPAM Pool (%s) CIDR: %w", poolID, err)
+	}
+
+	// The API may return the CIDR in its canonical form.
+	cidr := aws.StringValue(output.IpamPoolCidr.Cidr)
+
+	d.SetId(tfec2.IpamPoolCidrCreateID(cidr, poolID))
+
+	if _, err := waiter.IpamPoolCidrProvisioned(conn, poolID, cidr, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Pool CIDR (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpamPoolCidrRead(d, meta)
+}
+
+func resourceAwsVpcIpamPoolCidrRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	cidr, poolID, err := tfec2.IpamPoolCidrParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.IpamPoolCidrByPoolIDAndCidr(conn, poolID, cidr)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC IPAM Pool CIDR (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC IPAM Pool CIDR (%s): %w", d.Id(), err)
+	}
+
+	d.Set("cidr", output.Cidr)
+	d.Set("ipam_pool_id", poolID)
+
+	return nil
+}
+
+func resourceAwsVpcIpamPoolCidrDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	cidr, poolID, err := tfec2.IpamPoolCidrParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting EC2 VPC IPAM Pool CIDR: %s", d.Id())
+	_, err = conn.DeprovisionIpamPoolCidr(&ec2.DeprovisionIpamPoolCidrInput{
+		Cidr:       aws.String(cidr),
+		IpamPoolId: aws.String(poolID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamPoolIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 VPC IPAM Pool CIDR (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.IpamPoolCidrDeprovisioned(conn, poolID, cidr, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Pool CIDR (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEc2IpamCidrAuthorizationContext(tfMap map[string]interface{}) *ec2.IpamCidrAuthorizationContext {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.IpamCidrAuthorizationContext{}
+
+	if v, ok := tfMap["message"].(string); ok && v != "" {
+		apiObject.Message = aws.String(v)
+	}
+
+	if v, ok := tfMap["signature"].(string); ok && v != "" {
+		apiObject.Signature = aws.String(v)
+	}
+
+	return apiObject
+}
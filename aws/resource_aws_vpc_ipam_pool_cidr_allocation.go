@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsVpcIpamPoolCidrAllocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpamPoolCidrAllocationCreate,
+		Read:   resourceAwsVpcIpamPoolCidrAllocationRead,
+		Delete: resourceAwsVpcIpamPoolCidrAllocationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsCIDR,
+				ConflictsWith: []string{"netmask_length"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disallowed_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"ipam_pool_allocation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"netmask_length": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(0, 128),
+				ConflictsWith: []string{"cidr"},
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcIpamPoolCidrAllocationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	poolID := d.Get("ipam_pool_id").(string)
+	input := &ec2.AllocateIpamPoolCidrInput{
+		IpamPoolId: aws.String(poolID),
+	}
+
+	if v, ok := d.GetOk("cidr"); ok {
+		input.Cidr = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disallowed_cidrs"); ok && v.(*schema.Set).Len() > 0 {
+		input.DisallowedCidrs = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("netmask_length"); ok {
+		input.NetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC IPAM Pool CIDR Allocation: %s", input)
+	output, err := conn.AllocateIpamPoolCidr(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 VPC IPAM Pool (%s) CIDR Allocation: %w", poolID, err)
+	}
+
+	d.SetId(tfec2.IpamPoolCidrAllocationCreateID(aws.StringValue(output.IpamPoolAllocation.IpamPoolAllocationId), poolID))
+
+	return resourceAwsVpcIpamPoolCidrAllocationRead(d, meta)
+}
+
+func resourceAwsVpcIpamPoolCidrAllocationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	allocationID, poolID, err := tfec2.IpamPoolCidrAllocationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	allocation, err := finder.IpamPoolAllocationByIDAndPoolID(conn, allocationID, poolID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC IPAM Pool CIDR Allocation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC IPAM Pool CIDR Allocation (%s): %w", d.Id(), err)
+	}
+
+	d.Set("cidr", allocation.Cidr)
+	d.Set("description", allocation.Description)
+	d.Set("ipam_pool_allocation_id", allocation.IpamPoolAllocationId)
+	d.Set("ipam_pool_id", poolID)
+	d.Set("resource_id", allocation.ResourceId)
+	d.Set("resource_owner", allocation.ResourceOwner)
+	d.Set("resource_type", allocation.ResourceType)
+
+	return nil
+}
+
+func resourceAwsVpcIpamPoolCidrAllocationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	allocationID, poolID, err := tfec2.IpamPoolCidrAllocationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting EC2 VPC IPAM Pool CIDR Allocation: %s", d.Id())
+	_, err = conn.ReleaseIpamPoolAllocation(&ec2.ReleaseIpamPoolAllocationInput{
+		Cidr:                 aws.String(d.Get("cidr").(string)),
+		IpamPoolAllocationId: aws.String(allocationID),
+		IpamPoolId:           aws.String(poolID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamPoolAllocationIdNotFound) ||
+		tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamPoolIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 VPC IPAM Pool CIDR Allocation (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSVpcIpamPoolCidrAllocation_ipv4Basic(t *testing.T) {
+	var allocation ec2.IpamPoolAllocation
+	resourceName := "aws_vpc_ipam_pool_cidr_allocation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolCidrAllocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolCidrAllocationConfigIpv4("172.2.0.0/28"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolCidrAllocationExists(resourceName, &allocation),
+					resource.TestCheckResourceAttr(resourceName, "cidr", "172.2.0.0/28"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestMatchResourceAttr(resourceName, "ipam_pool_allocation_id", regexp.MustCompile(`^ipam-pool-alloc-[\da-f]+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", ec2.IpamPoolAllocationResourceTypeCustom),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"netmask_length"},
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpamPoolCidrAllocation_ipv4BasicNetmask(t *testing.T) {
+	var allocation ec2.IpamPoolAllocation
+	resourceName := "aws_vpc_ipam_pool_cidr_allocation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolCidrAllocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolCidrAllocationConfigIpv4Netmask(28),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolCidrAllocationExists(resourceName, &allocation),
+					resource.TestMatchResourceAttr(resourceName, "cidr", regexp.MustCompile(`^172\.2\.0\.\d+/28$`)),
+					resource.TestCheckResourceAttr(resourceName, "netmask_length", "28"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"netmask_length"},
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpamPoolCidrAllocation_ipv4DisallowedCidrs(t *testing.T) {
+	var allocation ec2.IpamPoolAllocation
+	resourceName := "aws_vpc_ipam_pool_cidr_allocation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolCidrAllocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolCidrAllocationConfigIpv4DisallowedCidrs(28, "172.2.0.0/28"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolCidrAllocationExists(resourceName, &allocation),
+					resource.TestCheckResourceAttr(resourceName, "cidr", "172.2.0.16/28"),
+					resource.TestCheckResourceAttr(resourceName, "disallowed_cidrs.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "disallowed_cidrs.*", "172.2.0.0/28"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disallowed_cidrs", "netmask_length"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSVpcIpamPoolCidrAllocationExists(n string, v *ec2.IpamPoolAllocation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM Pool CIDR Allocation ID is set")
+		}
+
+		allocationID, poolID, err := tfec2.IpamPoolCidrAllocationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.IpamPoolAllocationByIDAndPoolID(conn, allocationID, poolID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSVpcIpamPoolCidrAllocationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_pool_cidr_allocation" {
+			continue
+		}
+
+		allocationID, poolID, err := tfec2.IpamPoolCidrAllocationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.IpamPoolAllocationByIDAndPoolID(conn, allocationID, poolID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM Pool CIDR Allocation %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccAWSVpcIpamPoolCidrAllocationConfigBase = `
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+  locale         = data.aws_region.current.name
+}
+
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "172.2.0.0/24"
+}
+`
+
+func testAccAWSVpcIpamPoolCidrAllocationConfigIpv4(cidr string) string {
+	return composeConfig(testAccAWSVpcIpamPoolCidrAllocationConfigBase, fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr_allocation" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = %[1]q
+  description  = "test"
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, cidr))
+}
+
+func testAccAWSVpcIpamPoolCidrAllocationConfigIpv4Netmask(netmaskLength int) string {
+	return composeConfig(testAccAWSVpcIpamPoolCidrAllocationConfigBase, fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr_allocation" "test" {
+  ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  netmask_length = %[1]d
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, netmaskLength))
+}
+
+func testAccAWSVpcIpamPoolCidrAllocationConfigIpv4DisallowedCidrs(netmaskLength int, disallowedCidr string) string {
+	return composeConfig(testAccAWSVpcIpamPoolCidrAllocationConfigBase, fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr_allocation" "test" {
+  ipam_pool_id     = aws_vpc_ipam_pool.test.id
+  netmask_length   = %[1]d
+  disallowed_cidrs = [%[2]q]
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, netmaskLength, disallowedCidr))
+}
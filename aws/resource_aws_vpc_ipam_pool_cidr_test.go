@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSVpcIpamPoolCidr_basic(t *testing.T) {
+	var cidr ec2.IpamPoolCidr
+	resourceName := "aws_vpc_ipam_pool_cidr.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolCidrDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolCidrConfig("10.0.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolCidrExists(resourceName, &cidr),
+					resource.TestCheckResourceAttr(resourceName, "cidr", "10.0.0.0/24"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpamPoolCidr_disappears(t *testing.T) {
+	var cidr ec2.IpamPoolCidr
+	resourceName := "aws_vpc_ipam_pool_cidr.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolCidrDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolCidrConfig("10.0.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolCidrExists(resourceName, &cidr),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsVpcIpamPoolCidr(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSVpcIpamPoolCidrExists(n string, v *ec2.IpamPoolCidr) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM Pool CIDR ID is set")
+		}
+
+		cidr, poolID, err := tfec2.IpamPoolCidrParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.IpamPoolCidrByPoolIDAndCidr(conn, poolID, cidr)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSVpcIpamPoolCidrDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_pool_cidr" {
+			continue
+		}
+
+		cidr, poolID, err := tfec2.IpamPoolCidrParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.IpamPoolCidrByPoolIDAndCidr(conn, poolID, cidr)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM Pool CIDR %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccAWSVpcIpamPoolCidrConfigBase = `
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+  locale         = data.aws_region.current.name
+}
+`
+
+func testAccAWSVpcIpamPoolCidrConfig(cidr string) string {
+	return composeConfig(testAccAWSVpcIpamPoolCidrConfigBase, fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = %[1]q
+}
+`, cidr))
+}
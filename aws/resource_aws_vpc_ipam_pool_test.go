@@ -0,0 +1,321 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSVpcIpamPool_basic(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+	ipamName := "aws_vpc_ipam.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "address_family", ec2.AddressFamilyIpv4),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.%", "0"),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "ec2", regexp.MustCompile(`ipam-pool/ipam-pool-[\da-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_import", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_scope_id", ipamName, "private_default_scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "ipam_scope_type", ec2.IpamScopeTypePrivate),
+					resource.TestCheckResourceAttr(resourceName, "pool_depth", "1"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_advertisable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "source_ipam_pool_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.IpamPoolStateCreateComplete),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpamPool_disappears(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolExists(resourceName, &pool),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsVpcIpamPool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpamPool_Allocation(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolConfigAllocation(28, 16, 24, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "allocation_default_netmask_length", "24"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_max_netmask_length", "28"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_min_netmask_length", "16"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_import", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcIpamPoolConfigAllocation(26, 18, 20, "key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "allocation_default_netmask_length", "20"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_max_netmask_length", "26"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_min_netmask_length", "18"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.key1", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpamPool_SourceIpamPoolId(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+	parentName := "aws_vpc_ipam_pool.parent"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolConfigSourceIpamPoolId,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttrPair(resourceName, "locale", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "pool_depth", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_ipam_pool_id", parentName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpamPool_Tags(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcIpamPoolConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSVpcIpamPoolConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSVpcIpamPoolExists(n string, v *ec2.IpamPool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM Pool ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.IpamPoolByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSVpcIpamPoolDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_pool" {
+			continue
+		}
+
+		_, err := finder.IpamPoolByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM Pool %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccAWSVpcIpamPoolConfigBase = `
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+`
+
+var testAccAWSVpcIpamPoolConfig = composeConfig(testAccAWSVpcIpamPoolConfigBase, `
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+}
+`)
+
+func testAccAWSVpcIpamPoolConfigAllocation(maxNetmaskLength, minNetmaskLength, defaultNetmaskLength int, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSVpcIpamPoolConfigBase, fmt.Sprintf(`
+resource "aws_vpc_ipam_pool" "test" {
+  address_family                    = "ipv4"
+  allocation_default_netmask_length = %[3]d
+  allocation_max_netmask_length     = %[1]d
+  allocation_min_netmask_length     = %[2]d
+  auto_import                       = true
+  description                       = "test"
+  ipam_scope_id                     = aws_vpc_ipam.test.private_default_scope_id
+
+  allocation_resource_tags = {
+    %[4]q = %[5]q
+  }
+}
+`, maxNetmaskLength, minNetmaskLength, defaultNetmaskLength, tagKey1, tagValue1))
+}
+
+var testAccAWSVpcIpamPoolConfigSourceIpamPoolId = composeConfig(testAccAWSVpcIpamPoolConfigBase, `
+resource "aws_vpc_ipam_pool" "parent" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+}
+
+resource "aws_vpc_ipam_pool_cidr" "parent" {
+  ipam_pool_id = aws_vpc_ipam_pool.parent.id
+  cidr         = "10.0.0.0/16"
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family      = "ipv4"
+  ipam_scope_id       = aws_vpc_ipam.test.private_default_scope_id
+  locale              = data.aws_region.current.name
+  source_ipam_pool_id = aws_vpc_ipam_pool.parent.id
+
+  depends_on = [aws_vpc_ipam_pool_cidr.parent]
+}
+`)
+
+func testAccAWSVpcIpamPoolConfigTags1(tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSVpcIpamPoolConfigBase, fmt.Sprintf(`
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSVpcIpamPoolConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSVpcIpamPoolConfigBase, fmt.Sprintf(`
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
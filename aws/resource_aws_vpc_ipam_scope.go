@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsVpcIpamScope() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpamScopeCreate,
+		Read:   resourceAwsVpcIpamScopeRead,
+		Update: resourceAwsVpcIpamScopeUpdate,
+		Delete: resourceAwsVpcIpamScopeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"ipam_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipam_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ipam_scope_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"pool_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsVpcIpamScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateIpamScopeInput{
+		IpamId:            aws.String(d.Get("ipam_id").(string)),
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeIpamScope),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC IPAM Scope: %s", input)
+	output, err := conn.CreateIpamScope(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 VPC IPAM Scope: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.IpamScope.IpamScopeId))
+
+	if _, err := waiter.IpamScopeCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Scope (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpamScopeRead(d, meta)
+}
+
+func resourceAwsVpcIpamScopeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	scope, err := finder.IpamScopeByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC IPAM Scope (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC IPAM Scope (%s): %w", d.Id(), err)
+	}
+
+	ipamID, err := ec2IpamResourceIDFromArn(ec2.ResourceTypeIpam, aws.StringValue(scope.IpamArn))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", scope.IpamScopeArn)
+	d.Set("description", scope.Description)
+	d.Set("ipam_arn", scope.IpamArn)
+	d.Set("ipam_id", ipamID)
+	d.Set("ipam_scope_type", scope.IpamScopeType)
+	d.Set("is_default", scope.IsDefault)
+	d.Set("pool_count", scope.PoolCount)
+
+	tags := keyvaluetags.Ec2KeyValueTags(scope.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsVpcIpamScopeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("description") {
+		input := &ec2.ModifyIpamScopeInput{
+			Description: aws.String(d.Get("description").(string)),
+			IpamScopeId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating EC2 VPC IPAM Scope: %s", input)
+		if _, err := conn.ModifyIpamScope(input); err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM Scope (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.IpamScopeUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 VPC IPAM Scope (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM Scope (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsVpcIpamScopeRead(d, meta)
+}
+
+func resourceAwsVpcIpamScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[INFO] Deleting EC2 VPC IPAM Scope: %s", d.Id())
+	_, err := conn.DeleteIpamScope(&ec2.DeleteIpamScopeInput{
+		IpamScopeId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidIpamScopeIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 VPC IPAM Scope (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.IpamScopeDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Scope (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// ec2IpamResourceIDFromArn returns the resource ID from an IPAM, IPAM scope or IPAM pool ARN,
+// e.g. arn:aws:ec2::123456789012:ipam-scope/ipam-scope-0123456789abcdef0.
+func ec2IpamResourceIDFromArn(resourceType, v string) (string, error) {
+	parsedArn, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing ARN (%s): %w", v, err)
+	}
+
+	parts := strings.Split(parsedArn.Resource, "/")
+
+	if len(parts) != 2 || parts[0] != resourceType || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format for ARN resource (%[1]s), expected %[2]s/%[2]s-id", parsedArn.Resource, resourceType)
+	}
+
+	return parts[1], nil
+}
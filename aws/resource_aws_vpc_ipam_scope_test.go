@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSVpcIpamScope_basic(t *testing.T) {
+	var scope ec2.IpamScope
+	resourceName := "aws_vpc_ipam_scope.test"
+	ipamName := "aws_vpc_ipam.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamScopeConfigDescription("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamScopeExists(resourceName, &scope),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "ec2", regexp.MustCompile(`ipam-scope/ipam-scope-[\da-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_arn", ipamName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_id", ipamName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "ipam_scope_type", ec2.IpamScopeTypePrivate),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "pool_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcIpamScopeConfigDescription("test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamScopeExists(resourceName, &scope),
+					resource.TestCheckResourceAttr(resourceName, "description", "test2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpamScope_disappears(t *testing.T) {
+	var scope ec2.IpamScope
+	resourceName := "aws_vpc_ipam_scope.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamScopeConfigDescription("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamScopeExists(resourceName, &scope),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsVpcIpamScope(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpamScope_Tags(t *testing.T) {
+	var scope ec2.IpamScope
+	resourceName := "aws_vpc_ipam_scope.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamScopeConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamScopeExists(resourceName, &scope),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcIpamScopeConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamScopeExists(resourceName, &scope),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSVpcIpamScopeConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamScopeExists(resourceName, &scope),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSVpcIpamScopeExists(n string, v *ec2.IpamScope) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM Scope ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.IpamScopeByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSVpcIpamScopeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_scope" {
+			continue
+		}
+
+		_, err := finder.IpamScopeByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM Scope %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccAWSVpcIpamScopeConfigBase = `
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+`
+
+func testAccAWSVpcIpamScopeConfigDescription(description string) string {
+	return composeConfig(testAccAWSVpcIpamScopeConfigBase, fmt.Sprintf(`
+resource "aws_vpc_ipam_scope" "test" {
+  ipam_id     = aws_vpc_ipam.test.id
+  description = %[1]q
+}
+`, description))
+}
+
+func testAccAWSVpcIpamScopeConfigTags1(tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSVpcIpamScopeConfigBase, fmt.Sprintf(`
+resource "aws_vpc_ipam_scope" "test" {
+  ipam_id = aws_vpc_ipam.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSVpcIpamScopeConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSVpcIpamScopeConfigBase, fmt.Sprintf(`
+resource "aws_vpc_ipam_scope" "test" {
+  ipam_id = aws_vpc_ipam.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
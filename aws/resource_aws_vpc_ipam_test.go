@@ -0,0 +1,346 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSVpcIpam_basic(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "ec2", regexp.MustCompile(`ipam/ipam-[\da-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "private_default_scope_id", regexp.MustCompile(`^ipam-scope-[\da-f]+`)),
+					resource.TestMatchResourceAttr(resourceName, "public_default_scope_id", regexp.MustCompile(`^ipam-scope-[\da-f]+`)),
+					resource.TestCheckResourceAttr(resourceName, "scope_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpam_disappears(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsVpcIpam(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpam_Description(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamConfigDescription("test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "description", "test1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcIpamConfigDescription("test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "description", "test2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpam_OperatingRegions(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccMultipleRegionPreCheck(t, 2) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operating_regions.*", map[string]string{
+						"region_name": testAccGetRegion(),
+					}),
+				),
+			},
+			{
+				Config: testAccAWSVpcIpamConfigOperatingRegionsAlternate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operating_regions.*", map[string]string{
+						"region_name": testAccGetRegion(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operating_regions.*", map[string]string{
+						"region_name": testAccGetAlternateRegion(),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcIpamConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpam_OperatingRegions_CurrentRegionRequired(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccMultipleRegionPreCheck(t, 2) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSVpcIpamConfigOperatingRegionsAlternateOnly(),
+				ExpectError: regexp.MustCompile("`operating_regions` must include the current region"),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpam_Tags(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcIpamConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSVpcIpamConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSVpcIpamExists(n string, v *ec2.Ipam) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.IpamByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSVpcIpamDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam" {
+			continue
+		}
+
+		_, err := finder.IpamByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccAWSVpcIpamConfig = `
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = "test"
+
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+`
+
+func testAccAWSVpcIpamConfigDescription(description string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = %[1]q
+
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+`, description)
+}
+
+func testAccAWSVpcIpamConfigOperatingRegionsAlternate() string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = "test"
+
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  operating_regions {
+    region_name = %[1]q
+  }
+}
+`, testAccGetAlternateRegion())
+}
+
+func testAccAWSVpcIpamConfigOperatingRegionsAlternateOnly() string {
+	return fmt.Sprintf(`
+resource "aws_vpc_ipam" "test" {
+  description = "test"
+
+  operating_regions {
+    region_name = %[1]q
+  }
+}
+`, testAccGetAlternateRegion())
+}
+
+func testAccAWSVpcIpamConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSVpcIpamConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
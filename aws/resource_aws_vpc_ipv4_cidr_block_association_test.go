@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -43,6 +44,35 @@ func TestAccAwsVpcIpv4CidrBlockAssociation_basic(t *testing.T) {
 	})
 }
 
+func TestAccAwsVpcIpv4CidrBlockAssociation_IpamBasic(t *testing.T) {
+	var association ec2.VpcCidrBlockAssociation
+	resourceName := "aws_vpc_ipv4_cidr_block_association.secondary_cidr"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsVpcIpv4CidrBlockAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsVpcIpv4CidrBlockAssociationConfigIpam(28),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsVpcIpv4CidrBlockAssociationExists(resourceName, &association),
+					resource.TestMatchResourceAttr(resourceName, "cidr_block", regexp.MustCompile(`^172\.2\.0\.\d+/28$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "ipv4_ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_netmask_length", "28"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ipv4_ipam_pool_id", "ipv4_netmask_length"},
+			},
+		},
+	})
+}
+
 func testAccCheckAdditionalAwsVpcIpv4CidrBlock(association *ec2.VpcCidrBlockAssociation, expected string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		CIDRBlock := association.CidrBlock
@@ -151,3 +181,42 @@ resource "aws_vpc_ipv4_cidr_block_association" "tertiary_cidr" {
   cidr_block = "170.2.0.0/16"
 }
 `
+
+func testAccAwsVpcIpv4CidrBlockAssociationConfigIpam(netmaskLength int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+  locale         = data.aws_region.current.name
+}
+
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "172.2.0.0/16"
+}
+
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-vpc-ipv4-cidr-block-association"
+  }
+}
+
+resource "aws_vpc_ipv4_cidr_block_association" "secondary_cidr" {
+  vpc_id              = aws_vpc.foo.id
+  ipv4_ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  ipv4_netmask_length = %[1]d
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, netmaskLength)
+}
@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+)
+
+func resourceAwsVpcSecurityGroupEgressRule() *schema.Resource {
+	// reuse aws_vpc_security_group_ingress_rule schema
+	r := resourceAwsVpcSecurityGroupIngressRule()
+	r.Create = resourceAwsVpcSecurityGroupEgressRuleCreate
+	r.Read = resourceAwsVpcSecurityGroupEgressRuleRead
+	r.Update = resourceAwsVpcSecurityGroupEgressRuleUpdate
+	r.Delete = resourceAwsVpcSecurityGroupEgressRuleDelete
+
+	return r
+}
+
+func resourceAwsVpcSecurityGroupEgressRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	input := &ec2.AuthorizeSecurityGroupEgressInput{
+		GroupId:           aws.String(securityGroupID),
+		IpPermissions:     []*ec2.IpPermission{expandEc2VpcSecurityGroupRuleIpPermission(d)},
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC Security Group Egress Rule: %s", input)
+	output, err := conn.AuthorizeSecurityGroupEgress(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 VPC Security Group (%s) Egress Rule: %w", securityGroupID, err)
+	}
+
+	if output == nil || len(output.SecurityGroupRules) != 1 || output.SecurityGroupRules[0] == nil {
+		return fmt.Errorf("error creating EC2 VPC Security Group (%s) Egress Rule: unexpected response", securityGroupID)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroupRules[0].SecurityGroupRuleId))
+
+	return resourceAwsVpcSecurityGroupEgressRuleRead(d, meta)
+}
+
+func resourceAwsVpcSecurityGroupEgressRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return resourceAwsVpcSecurityGroupRuleRead(d, meta, true)
+}
+
+func resourceAwsVpcSecurityGroupEgressRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAwsVpcSecurityGroupRuleUpdate(d, meta); err != nil {
+		return err
+	}
+
+	return resourceAwsVpcSecurityGroupEgressRuleRead(d, meta)
+}
+
+func resourceAwsVpcSecurityGroupEgressRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[INFO] Deleting EC2 VPC Security Group Egress Rule: %s", d.Id())
+	_, err := conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+		GroupId:              aws.String(d.Get("security_group_id").(string)),
+		SecurityGroupRuleIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidSecurityGroupRuleIdNotFound) || tfawserr.ErrCodeEquals(err, tfec2.InvalidSecurityGroupIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 VPC Security Group Egress Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSVpcSecurityGroupEgressRule_basic(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_security_group_egress_rule.test"
+	securityGroupResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcSecurityGroupEgressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcSecurityGroupEgressRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`security-group-rule/sgr-[\da-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv4", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv6", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "from_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "prefix_list_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "referenced_security_group_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", securityGroupResourceName, "id"),
+					resource.TestMatchResourceAttr(resourceName, "security_group_rule_id", regexp.MustCompile(`^sgr-[\da-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "to_port", "8080"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcSecurityGroupEgressRule_disappears(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_security_group_egress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcSecurityGroupEgressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcSecurityGroupEgressRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsVpcSecurityGroupEgressRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSVpcSecurityGroupEgressRuleDestroy(s *terraform.State) error {
+	return testAccCheckAWSVpcSecurityGroupRuleDestroy(s, "aws_vpc_security_group_egress_rule")
+}
+
+func testAccAWSVpcSecurityGroupEgressRuleConfig(rName string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupRuleConfigBase(rName), `
+resource "aws_vpc_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+`)
+}
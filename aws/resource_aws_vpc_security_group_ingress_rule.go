@@ -0,0 +1,360 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsVpcSecurityGroupIngressRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcSecurityGroupIngressRuleCreate,
+		Read:   resourceAwsVpcSecurityGroupIngressRuleRead,
+		Update: resourceAwsVpcSecurityGroupIngressRuleUpdate,
+		Delete: resourceAwsVpcSecurityGroupIngressRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			vpcSecurityGroupRulePortsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_ipv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIpv4CIDRNetworkAddress,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"cidr_ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIpv6CIDRNetworkAddress,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"from_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+			"ip_protocol": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: protocolStateFunc,
+			},
+			"prefix_list_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"referenced_security_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"to_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+		},
+	}
+}
+
+func resourceAwsVpcSecurityGroupIngressRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	input := &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:           aws.String(securityGroupID),
+		IpPermissions:     []*ec2.IpPermission{expandEc2VpcSecurityGroupRuleIpPermission(d)},
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC Security Group Ingress Rule: %s", input)
+	output, err := conn.AuthorizeSecurityGroupIngress(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 VPC Security Group (%s) Ingress Rule: %w", securityGroupID, err)
+	}
+
+	if output == nil || len(output.SecurityGroupRules) != 1 || output.SecurityGroupRules[0] == nil {
+		return fmt.Errorf("error creating EC2 VPC Security Group (%s) Ingress Rule: unexpected response", securityGroupID)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroupRules[0].SecurityGroupRuleId))
+
+	return resourceAwsVpcSecurityGroupIngressRuleRead(d, meta)
+}
+
+func resourceAwsVpcSecurityGroupIngressRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return resourceAwsVpcSecurityGroupRuleRead(d, meta, false)
+}
+
+func resourceAwsVpcSecurityGroupIngressRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAwsVpcSecurityGroupRuleUpdate(d, meta); err != nil {
+		return err
+	}
+
+	return resourceAwsVpcSecurityGroupIngressRuleRead(d, meta)
+}
+
+func resourceAwsVpcSecurityGroupIngressRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[INFO] Deleting EC2 VPC Security Group Ingress Rule: %s", d.Id())
+	_, err := conn.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+		GroupId:              aws.String(d.Get("security_group_id").(string)),
+		SecurityGroupRuleIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidSecurityGroupRuleIdNotFound) || tfawserr.ErrCodeEquals(err, tfec2.InvalidSecurityGroupIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 VPC Security Group Ingress Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsVpcSecurityGroupRuleRead is shared by the ingress and egress rule resources.
+func resourceAwsVpcSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}, isEgress bool) error {
+	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	ruleType := "Ingress"
+	if isEgress {
+		ruleType = "Egress"
+	}
+
+	output, err := finder.SecurityGroupRuleByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC Security Group %s Rule (%s) not found, removing from state", ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC Security Group %s Rule (%s): %w", ruleType, d.Id(), err)
+	}
+
+	if aws.BoolValue(output.IsEgress) != isEgress {
+		return fmt.Errorf("error reading EC2 VPC Security Group %s Rule (%s): rule is not an %s rule", ruleType, d.Id(), ruleType)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: aws.StringValue(output.GroupOwnerId),
+		Resource:  fmt.Sprintf("security-group-rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("cidr_ipv4", output.CidrIpv4)
+	d.Set("cidr_ipv6", output.CidrIpv6)
+	d.Set("description", output.Description)
+	d.Set("ip_protocol", output.IpProtocol)
+	d.Set("prefix_list_id", output.PrefixListId)
+	if output.ReferencedGroupInfo != nil {
+		d.Set("referenced_security_group_id", output.ReferencedGroupInfo.GroupId)
+	} else {
+		d.Set("referenced_security_group_id", nil)
+	}
+	d.Set("security_group_id", output.GroupId)
+	d.Set("security_group_rule_id", output.SecurityGroupRuleId)
+
+	// The API reports -1 for the ports of an "all protocols" rule.
+	if aws.StringValue(output.IpProtocol) == "-1" {
+		d.Set("from_port", nil)
+		d.Set("to_port", nil)
+	} else {
+		d.Set("from_port", output.FromPort)
+		d.Set("to_port", output.ToPort)
+	}
+
+	tags := keyvaluetags.Ec2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+// resourceAwsVpcSecurityGroupRuleUpdate is shared by the ingress and egress rule resources.
+// The caller is responsible for refreshing state afterwards.
+func resourceAwsVpcSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId: aws.String(d.Get("security_group_id").(string)),
+			SecurityGroupRules: []*ec2.SecurityGroupRuleUpdate{{
+				SecurityGroupRule:   expandEc2VpcSecurityGroupRuleRequest(d),
+				SecurityGroupRuleId: aws.String(d.Id()),
+			}},
+		}
+
+		log.Printf("[DEBUG] Updating EC2 VPC Security Group Rule: %s", input)
+		_, err := conn.ModifySecurityGroupRules(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating EC2 VPC Security Group Rule (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 VPC Security Group Rule (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// vpcSecurityGroupRulePortsDiff returns an error if from_port and to_port are not both
+// configured for a specific protocol. Ports of 0 are valid, e.g. the ICMP echo reply type,
+// so they are checked for existence rather than for their zero value.
+func vpcSecurityGroupRulePortsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("ip_protocol") || protocolForValue(diff.Get("ip_protocol").(string)) == "-1" {
+		return nil
+	}
+
+	if !diff.NewValueKnown("from_port") || !diff.NewValueKnown("to_port") {
+		return nil
+	}
+
+	_, fromPortOk := diff.GetOkExists("from_port")
+	_, toPortOk := diff.GetOkExists("to_port")
+
+	if !fromPortOk || !toPortOk {
+		return fmt.Errorf("from_port and to_port must both be set when ip_protocol is not -1")
+	}
+
+	return nil
+}
+
+func expandEc2VpcSecurityGroupRuleIpPermission(d *schema.ResourceData) *ec2.IpPermission {
+	apiObject := &ec2.IpPermission{
+		IpProtocol: aws.String(protocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if aws.StringValue(apiObject.IpProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	var description *string
+	if v, ok := d.GetOk("description"); ok {
+		description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			Description:  description,
+			PrefixListId: aws.String(v.(string)),
+		}}
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{{
+			Description: description,
+			GroupId:     aws.String(v.(string)),
+		}}
+	}
+
+	return apiObject
+}
+
+func expandEc2VpcSecurityGroupRuleRequest(d *schema.ResourceData) *ec2.SecurityGroupRuleRequest {
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		IpProtocol: aws.String(protocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if aws.StringValue(apiObject.IpProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.CidrIpv4 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.CidrIpv6 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		apiObject.ReferencedGroupId = aws.String(v.(string))
+	}
+
+	return apiObject
+}
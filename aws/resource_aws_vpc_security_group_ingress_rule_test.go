@@ -0,0 +1,521 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSVpcSecurityGroupIngressRule_basic(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+	securityGroupResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`security-group-rule/sgr-[\da-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv4", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv6", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "from_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "prefix_list_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "referenced_security_group_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", securityGroupResourceName, "id"),
+					resource.TestMatchResourceAttr(resourceName, "security_group_rule_id", regexp.MustCompile(`^sgr-[\da-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "to_port", "8080"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcSecurityGroupIngressRule_disappears(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsVpcSecurityGroupIngressRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcSecurityGroupIngressRule_Tags(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcSecurityGroupIngressRule_Description(t *testing.T) {
+	var v1, v2 ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfigDescription(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfigDescription(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v2),
+					testAccCheckAWSVpcSecurityGroupRuleNotRecreated(&v2, &v1),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcSecurityGroupIngressRule_CidrIpv6(t *testing.T) {
+	var v1, v2 ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfigCidrIpv6(rName, "2001:db8:85a3::/64"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv4", ""),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv6", "2001:db8:85a3::/64"),
+					resource.TestCheckResourceAttr(resourceName, "from_port", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "to_port", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfigCidrIpv6(rName, "2001:db8:85a3:2::/64"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v2),
+					testAccCheckAWSVpcSecurityGroupRuleNotRecreated(&v2, &v1),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv6", "2001:db8:85a3:2::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcSecurityGroupIngressRule_PrefixListId(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+	prefixListResourceName := "aws_ec2_managed_prefix_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfigPrefixListId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv4", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "prefix_list_id", prefixListResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcSecurityGroupIngressRule_ReferencedSecurityGroupId(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+	securityGroupResourceName := "aws_security_group.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfigReferencedSecurityGroupId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv4", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "referenced_security_group_id", securityGroupResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcSecurityGroupIngressRule_IpProtocolNumber(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcSecurityGroupIngressRuleConfigIpProtocol(rName, "6"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcSecurityGroupRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "tcp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcSecurityGroupIngressRule_MissingPorts(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ec2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSVpcSecurityGroupIngressRuleConfigFromPortOnly(rName),
+				ExpectError: regexp.MustCompile(`from_port and to_port must both be set when ip_protocol is not -1`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSVpcSecurityGroupRuleExists(n string, v *ec2.SecurityGroupRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC Security Group Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.SecurityGroupRuleByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSVpcSecurityGroupRuleNotRecreated(i, j *ec2.SecurityGroupRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.SecurityGroupRuleId) != aws.StringValue(j.SecurityGroupRuleId) {
+			return fmt.Errorf("EC2 VPC Security Group Rule recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSVpcSecurityGroupIngressRuleDestroy(s *terraform.State) error {
+	return testAccCheckAWSVpcSecurityGroupRuleDestroy(s, "aws_vpc_security_group_ingress_rule")
+}
+
+func testAccCheckAWSVpcSecurityGroupRuleDestroy(s *terraform.State, resourceType string) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		_, err := finder.SecurityGroupRuleByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC Security Group Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSVpcSecurityGroupRuleConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+  name   = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSVpcSecurityGroupIngressRuleConfig(rName string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupRuleConfigBase(rName), `
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+`)
+}
+
+func testAccAWSVpcSecurityGroupIngressRuleConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSVpcSecurityGroupIngressRuleConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccAWSVpcSecurityGroupIngressRuleConfigDescription(rName, description string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  description = %[1]q
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+`, description))
+}
+
+func testAccAWSVpcSecurityGroupIngressRuleConfigCidrIpv6(rName, cidr string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv6   = %[1]q
+  ip_protocol = "-1"
+}
+`, cidr))
+}
+
+func testAccAWSVpcSecurityGroupIngressRuleConfigPrefixListId(rName string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+  from_port      = 80
+  ip_protocol    = "tcp"
+  to_port        = 8080
+}
+`, rName))
+}
+
+func testAccAWSVpcSecurityGroupIngressRuleConfigReferencedSecurityGroupId(rName string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_security_group" "test2" {
+  vpc_id = aws_vpc.test.id
+  name   = "%[1]s-2"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  referenced_security_group_id = aws_security_group.test2.id
+  from_port                    = 80
+  ip_protocol                  = "tcp"
+  to_port                      = 8080
+}
+`, rName))
+}
+
+func testAccAWSVpcSecurityGroupIngressRuleConfigFromPortOnly(rName string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupRuleConfigBase(rName), `
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+}
+`)
+}
+
+func testAccAWSVpcSecurityGroupIngressRuleConfigIpProtocol(rName, ipProtocol string) string {
+	return composeConfig(testAccAWSVpcSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = %[1]q
+  to_port     = 8080
+}
+`, ipProtocol))
+}
@@ -65,20 +65,6 @@ func ec2TagsFromTagDescriptions(tds []*ec2.TagDescription) []*ec2.Tag {
 	return tags
 }
 
-// ec2TagSpecificationsFromMap returns the tag specifications for the given tag key/value map and resource type.
-func ec2TagSpecificationsFromMap(m map[string]interface{}, t string) []*ec2.TagSpecification {
-	if len(m) == 0 {
-		return nil
-	}
-
-	return []*ec2.TagSpecification{
-		{
-			ResourceType: aws.String(t),
-			Tags:         keyvaluetags.New(m).IgnoreAws().Ec2Tags(),
-		},
-	}
-}
-
 // ec2TagSpecificationsFromKeyValueTags returns the tag specifications for the given KeyValueTags object and resource type.
 func ec2TagSpecificationsFromKeyValueTags(tags keyvaluetags.KeyValueTags, t string) []*ec2.TagSpecification {
 	if len(tags) == 0 {
@@ -93,6 +79,13 @@ func ec2TagSpecificationsFromKeyValueTags(tags keyvaluetags.KeyValueTags, t stri
 	}
 }
 
+// ec2SubResourceTags returns the tags for EC2 resources created through the tag specifications of
+// another resource, e.g. the volumes and network interfaces launched with an instance: the given tag
+// key/value map merged on to those defined at the provider-level, excluding ignored tags.
+func ec2SubResourceTags(m map[string]interface{}, defaultTagsConfig *keyvaluetags.DefaultConfig, ignoreTagsConfig *keyvaluetags.IgnoreConfig) keyvaluetags.KeyValueTags {
+	return defaultTagsConfig.MergeTags(keyvaluetags.New(m)).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+}
+
 // SetTagsDiff sets the new plan difference with the result of
 // merging resource tags on to those defined at the provider-level;
 // returns an error if unsuccessful or if the resource tags are identical
@@ -102,6 +95,7 @@ func ec2TagSpecificationsFromKeyValueTags(tags keyvaluetags.KeyValueTags, t stri
 func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	requiredTagsConfig := meta.(*AWSClient).RequiredTagsConfig
 
 	resourceTags := keyvaluetags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -111,6 +105,14 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+	// Tag values are not yet known when "tags" references other resources' attributes,
+	// so the required tags are only evaluated once they are.
+	if diff.NewValueKnown("tags") {
+		if err := requiredTagsConfig.Enforce(allTags.IgnoreAws()); err != nil {
+			return err
+		}
+	}
+
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when their is a known diff (excluding an empty map)
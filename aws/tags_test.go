@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func TestEc2SubResourceTags(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Tags              map[string]interface{}
+		DefaultTagsConfig *keyvaluetags.DefaultConfig
+		IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
+		Expected          map[string]string
+	}{
+		{
+			Name:     "no tags",
+			Expected: map[string]string{},
+		},
+		{
+			Name: "tags only",
+			Tags: map[string]interface{}{
+				"key1": "value1",
+			},
+			Expected: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			Name: "default tags only",
+			DefaultTagsConfig: &keyvaluetags.DefaultConfig{
+				Tags: keyvaluetags.New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			Expected: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			Name: "tags override default tags",
+			Tags: map[string]interface{}{
+				"key1": "override",
+				"key2": "value2",
+			},
+			DefaultTagsConfig: &keyvaluetags.DefaultConfig{
+				Tags: keyvaluetags.New(map[string]string{
+					"key1": "value1",
+					"key3": "value3",
+				}),
+			},
+			Expected: map[string]string{
+				"key1": "override",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			Name: "ignored keys",
+			Tags: map[string]interface{}{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			DefaultTagsConfig: &keyvaluetags.DefaultConfig{
+				Tags: keyvaluetags.New(map[string]string{
+					"key2": "default",
+					"key3": "value3",
+				}),
+			},
+			IgnoreTagsConfig: &keyvaluetags.IgnoreConfig{
+				Keys: keyvaluetags.New([]string{"key2", "key3"}),
+			},
+			Expected: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			Name: "ignored key prefixes",
+			Tags: map[string]interface{}{
+				"key1":      "value1",
+				"team:name": "value2",
+			},
+			DefaultTagsConfig: &keyvaluetags.DefaultConfig{
+				Tags: keyvaluetags.New(map[string]string{
+					"team:cost-center": "value3",
+					"key4":             "value4",
+				}),
+			},
+			IgnoreTagsConfig: &keyvaluetags.IgnoreConfig{
+				KeyPrefixes: keyvaluetags.New([]string{"team:"}),
+			},
+			Expected: map[string]string{
+				"key1": "value1",
+				"key4": "value4",
+			},
+		},
+		{
+			Name: "AWS tags",
+			Tags: map[string]interface{}{
+				"aws:cloudformation:stack-name": "value1",
+				"key2":                          "value2",
+			},
+			DefaultTagsConfig: &keyvaluetags.DefaultConfig{
+				Tags: keyvaluetags.New(map[string]string{
+					"aws:autoscaling:groupName": "value3",
+				}),
+			},
+			Expected: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := ec2SubResourceTags(testCase.Tags, testCase.DefaultTagsConfig, testCase.IgnoreTagsConfig)
+
+			if !reflect.DeepEqual(got.Map(), testCase.Expected) {
+				t.Errorf("got %v, expected %v", got.Map(), testCase.Expected)
+			}
+		})
+	}
+}
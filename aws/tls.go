@@ -108,6 +108,7 @@ func tlsRsaX509LocallySignedCertificatePem(caKeyPem, caCertificatePem, keyPem, c
 
 	certificate := &x509.Certificate{
 		BasicConstraintsValid: true,
+		DNSNames:              []string{commonName},
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
 		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
 		NotAfter:              time.Now().Add(24 * time.Hour),
@@ -167,7 +168,7 @@ func tlsRsaX509SelfSignedCaCertificatePem(keyPem string) string {
 		BasicConstraintsValid: true,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
 		IsCA:                  true,
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
 		NotAfter:              time.Now().Add(24 * time.Hour),
 		NotBefore:             time.Now(),
 		SerialNumber:          serialNumber,
@@ -2497,3 +2497,27 @@ func validateVersionString(v interface{}, k string) (ws []string, errors []error
 
 	return
 }
+
+// validateDurationBetween returns a SchemaValidateFunc which tests if the provided value
+// is of type string, can be parsed as a time.Duration and is between min and max (inclusive).
+func validateDurationBetween(min, max time.Duration) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%s: cannot parse '%s' as a duration: %w", k, value, err))
+			return
+		}
+
+		if duration < min || duration > max {
+			errors = append(errors, fmt.Errorf("expected %s to be between %s and %s, got %s", k, min, max, duration))
+		}
+
+		return
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -3345,3 +3346,49 @@ func TestResourceAWSEKSClusterNameValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDurationBetween(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "15m",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1h30m",
+			ErrCount: 0,
+		},
+		{
+			Value:    "12h",
+			ErrCount: 0,
+		},
+		{
+			Value:    "14m59s",
+			ErrCount: 1,
+		},
+		{
+			Value:    "12h1s",
+			ErrCount: 1,
+		},
+		{
+			Value:    "3600",
+			ErrCount: 1,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+	}
+
+	f := validateDurationBetween(15*time.Minute, 12*time.Hour)
+
+	for _, tc := range cases {
+		_, errors := f(tc.Value, "duration")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %q to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}